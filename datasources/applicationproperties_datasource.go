@@ -0,0 +1,96 @@
+package datasources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"terraform-provider-hashicups-pf/services/applicationpropertyservice"
+	models2 "terraform-provider-hashicups-pf/services/applicationpropertyservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func ApplicationPropertiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+				JiraServerBase: client,
+			}
+
+			settings, err := applicationPropertyService.List(ctx, models2.ApplicationPropertyListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			properties := []interface{}{}
+			for _, property := range settings.Properties {
+				properties = append(properties, map[string]interface{}{
+					"key":            property.Key,
+					"value":          property.Value,
+					"name":           property.Name,
+					"description":    property.Desc,
+					"type":           property.Type,
+					"default_value":  property.DefaultValue,
+					"allowed_values": property.AllowedValues,
+				})
+			}
+			if err = data.Set("property", properties); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("advanced-settings")
+			log.Println("success list advanced settings")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"property": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "advanced settings of jira",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "key of application property",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "value of application property",
+						},
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "display name of application property",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "description of application property",
+						},
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "type of application property",
+						},
+						"default_value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "default value of application property",
+						},
+						"allowed_values": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "allowed values of application property",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/agileservice"
+	models2 "terraform-provider-hashicups-pf/services/agileservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func BoardConfigurationDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			agileService := agileservice.AgileService{
+				JiraServerBase: client,
+			}
+
+			boards, err := agileService.ListBoards(ctx, models2.BoardListRequestModel{
+				Name: name,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// the name query parameter matches substrings, keep the exact match only
+			foundBoard := models2.BoardGetResponseModel{}
+			for _, board := range boards.Boards {
+				if board.Name == name {
+					foundBoard = board
+					break
+				}
+			}
+			if foundBoard.Id == 0 {
+				return diag.FromErr(errors.New("failed to find board " + name))
+			}
+
+			boardId := strconv.FormatInt(foundBoard.Id, 10)
+			configuration, err := agileService.GetBoardConfiguration(ctx, models2.BoardConfigurationGetRequestModel{
+				BoardId: boardId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("type", foundBoard.Type); err != nil {
+				return diag.FromErr(err)
+			}
+
+			filterId, _ := strconv.Atoi(configuration.Filter.Id)
+			if err = data.Set("filter_id", filterId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", foundBoard.Location.ProjectKey); err != nil {
+				return diag.FromErr(err)
+			}
+
+			columns := []interface{}{}
+			for _, column := range configuration.ColumnConfig.Columns {
+				statusIds := []interface{}{}
+				for _, status := range column.Statuses {
+					statusId, _ := strconv.Atoi(status.Id)
+					statusIds = append(statusIds, statusId)
+				}
+				columns = append(columns, map[string]interface{}{
+					"name":       column.Name,
+					"status_ids": statusIds,
+					"min":        int(column.Min),
+					"max":        int(column.Max),
+				})
+			}
+			if err = data.Set("column", columns); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("constraint_type", configuration.ColumnConfig.ConstraintType); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("estimation_type", configuration.Estimation.Type); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("estimation_field_id", configuration.Estimation.Field.FieldId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("estimation_field_name", configuration.Estimation.Field.DisplayName); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("rank_custom_field_id", int(configuration.Ranking.RankCustomFieldId)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("board_id", int(foundBoard.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(boardId)
+			log.Println("success get board configuration")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of board",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "type of board, scrum or kanban",
+			},
+			"filter_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of filter selecting the issues of board",
+			},
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "key of project the board is located in",
+			},
+			"column": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "columns of board in display order",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "name of column",
+						},
+						"status_ids": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "ids of statuses mapped to column",
+						},
+						"min": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "minimum issue constraint of column, 0 when unset",
+						},
+						"max": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "maximum issue constraint of column, 0 when unset",
+						},
+					},
+				},
+			},
+			"constraint_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "column constraint type of board",
+			},
+			"estimation_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "estimation type of board, scrum boards only",
+			},
+			"estimation_field_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "id of field used for estimation",
+			},
+			"estimation_field_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "display name of field used for estimation",
+			},
+			"rank_custom_field_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of custom field used for ranking",
+			},
+			"board_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of board",
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuelinktypeservice"
+	models2 "terraform-provider-hashicups-pf/services/issuelinktypeservice/models"
+)
+
+func IssueLinkTypeDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			issueLinkTypeService := issuelinktypeservice.IssueLinkTypeService{
+				JiraServerBase: client,
+			}
+
+			linkTypes, err := issueLinkTypeService.List(ctx, models2.IssueLinkTypeListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			foundLinkType := models2.IssueLinkTypeGetResponseModel{}
+			for _, linkType := range linkTypes.IssueLinkTypes {
+				if linkType.Name == name {
+					foundLinkType = linkType
+					break
+				}
+			}
+			if foundLinkType.Id == "" {
+				return diag.FromErr(errors.New("failed to find issue link type " + name))
+			}
+
+			if err = data.Set("inward", foundLinkType.Inward); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("outward", foundLinkType.Outward); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundLinkType.Id)
+			if err = data.Set("issue_link_type_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundLinkType.Id)
+			log.Println("success get issue link type")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of issue link type",
+			},
+			"inward": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "inward description of issue link type",
+			},
+			"outward": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "outward description of issue link type",
+			},
+			"issue_link_type_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of issue link type",
+			},
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuesecurityschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/issuesecurityschemeservice/models"
+)
+
+func IssueSecuritySchemeDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			issueSecuritySchemeService := issuesecurityschemeservice.IssueSecuritySchemeService{
+				JiraServerBase: client,
+			}
+
+			schemes, err := issueSecuritySchemeService.List(ctx, models2.IssueSecuritySchemeListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			schemeId := ""
+			for _, scheme := range schemes.IssueSecuritySchemes {
+				if scheme.Name == name {
+					schemeId = strconv.FormatInt(scheme.Id, 10)
+					break
+				}
+			}
+			if schemeId == "" {
+				return diag.FromErr(errors.New("failed to find issue security scheme " + name))
+			}
+
+			foundScheme, err := issueSecuritySchemeService.Get(ctx, models2.IssueSecuritySchemeGetRequestModel{
+				Id: schemeId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("default_level_id", int(foundScheme.DefaultSecurityLevelId)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			levels := []interface{}{}
+			for _, level := range foundScheme.Levels {
+				levelId, _ := strconv.Atoi(level.Id)
+				levels = append(levels, map[string]interface{}{
+					"name":        level.Name,
+					"description": level.Description,
+					"level_id":    levelId,
+				})
+			}
+			if err = data.Set("level", levels); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("issue_security_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(schemeId)
+			log.Println("success get issue security scheme")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of issue security scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of issue security scheme",
+			},
+			"default_level_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of default security level",
+			},
+			"level": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "security levels of the scheme",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "name of security level",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "description of security level",
+						},
+						"level_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "id of security level",
+						},
+					},
+				},
+			},
+			"issue_security_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of issue security scheme",
+			},
+		},
+	}
+}
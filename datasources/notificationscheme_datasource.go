@@ -0,0 +1,109 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/resources"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/notificationschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/notificationschemeservice/models"
+)
+
+func NotificationSchemeDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+				JiraServerBase: client,
+			}
+
+			schemes, err := notificationSchemeService.List(ctx, models2.NotificationSchemeListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			schemeId := ""
+			for _, scheme := range schemes.NotificationSchemes {
+				if scheme.Name == name {
+					schemeId = strconv.FormatInt(scheme.Id, 10)
+					break
+				}
+			}
+			if schemeId == "" {
+				return diag.FromErr(errors.New("failed to find notification scheme " + name))
+			}
+
+			foundScheme, err := notificationSchemeService.Get(ctx, models2.NotificationSchemeGetRequestModel{
+				Id: schemeId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("notification", resources.FlattenNotificationSchemeNotifications(foundScheme.Notifications)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("notification_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(schemeId)
+			log.Println("success get notification scheme")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of notification scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of notification scheme",
+			},
+			"notification": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "event to recipient mappings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "id of event, e.g. 1 for issue created",
+						},
+						"recipient_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "type of recipient, e.g. Group, ProjectRole, User, Reporter, CurrentAssignee or AllWatchers",
+						},
+						"recipient_parameter": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "parameter of recipient, e.g. group name, project role id, user key or custom field id",
+						},
+					},
+				},
+			},
+			"notification_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of notification scheme",
+			},
+		},
+	}
+}
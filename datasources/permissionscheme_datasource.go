@@ -0,0 +1,92 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/resources"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/permissionschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/permissionschemeservice/models"
+)
+
+func PermissionSchemeDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			permissionSchemeService := permissionschemeservice.PermissionSchemeService{
+				JiraServerBase: client,
+			}
+
+			permissionSchemes, err := permissionSchemeService.List(ctx, models2.PermissionSchemeListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// the default permission scheme has id 0, so a zero id does not mean not found
+			schemeId, found := int64(0), false
+			for _, permissionScheme := range permissionSchemes.PermissionSchemes {
+				if permissionScheme.Name == name {
+					schemeId, found = permissionScheme.Id, true
+					break
+				}
+			}
+			if !found {
+				return diag.FromErr(errors.New("failed to find permission scheme " + name))
+			}
+
+			foundPermSch, err := permissionSchemeService.Get(ctx, models2.PermissionSchemeGetRequestModel{
+				Id: schemeId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundPermSch.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("grant", resources.FlattenPermissionSchemeGrants(foundPermSch.Permissions)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("permission_scheme_id", int(foundPermSch.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundPermSch.Id, 10))
+			log.Println("success get permission scheme")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of permission scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of permission scheme",
+			},
+			"grant": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "grants of permission scheme with their holder details",
+				Elem:        resources.PermissionSchemeGrantSchema(),
+			},
+			"permission_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of permission scheme",
+			},
+		},
+	}
+}
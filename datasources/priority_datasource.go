@@ -0,0 +1,101 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/priorityservice"
+	models2 "terraform-provider-hashicups-pf/services/priorityservice/models"
+)
+
+func PriorityDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			priorityService := priorityservice.PriorityService{
+				JiraServerBase: client,
+			}
+
+			priorities, err := priorityService.List(ctx, models2.PriorityListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			foundPriority := models2.PriorityGetResponseModel{}
+			for _, priority := range priorities {
+				if priority.Name == name {
+					foundPriority = priority
+					break
+				}
+			}
+			if foundPriority.Id == "" {
+				return diag.FromErr(errors.New("failed to find priority " + name))
+			}
+
+			if err = data.Set("description", foundPriority.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("status_color", foundPriority.StatusColor); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("icon_url", foundPriority.IconUrl); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("sequence", int(foundPriority.Sequence)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundPriority.Id)
+			if err = data.Set("priority_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundPriority.Id)
+			log.Println("success get priority")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of priority",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of priority",
+			},
+			"status_color": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "color of priority as hex",
+			},
+			"icon_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "url of priority icon",
+			},
+			"sequence": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "position of priority in jira's ordering, starting at 1",
+			},
+			"priority_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of priority",
+			},
+		},
+	}
+}
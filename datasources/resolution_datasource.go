@@ -0,0 +1,74 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/resolutionservice"
+	models2 "terraform-provider-hashicups-pf/services/resolutionservice/models"
+)
+
+func ResolutionDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			resolutionService := resolutionservice.ResolutionService{
+				JiraServerBase: client,
+			}
+
+			resolutions, err := resolutionService.List(ctx, models2.ResolutionListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			foundResolution := models2.ResolutionGetResponseModel{}
+			for _, resolution := range resolutions {
+				if resolution.Name == name {
+					foundResolution = resolution
+					break
+				}
+			}
+			if foundResolution.Id == "" {
+				return diag.FromErr(errors.New("failed to find resolution " + name))
+			}
+
+			if err = data.Set("description", foundResolution.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundResolution.Id)
+			if err = data.Set("resolution_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundResolution.Id)
+			log.Println("success get resolution")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of resolution",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of resolution",
+			},
+			"resolution_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of resolution",
+			},
+		},
+	}
+}
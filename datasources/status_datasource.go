@@ -0,0 +1,83 @@
+package datasources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/statusservice"
+	models2 "terraform-provider-hashicups-pf/services/statusservice/models"
+)
+
+func StatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: client,
+			}
+
+			statuses, err := statusService.List(ctx, models2.StatusListRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			foundStatus := models2.StatusGetResponseModel{}
+			for _, status := range statuses {
+				if status.Name == name {
+					foundStatus = status
+					break
+				}
+			}
+			if foundStatus.Id == "" {
+				return diag.FromErr(errors.New("failed to find status " + name))
+			}
+
+			if err = data.Set("description", foundStatus.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("category", statusservice.Categories[foundStatus.StatusCategory.Key]); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundStatus.Id)
+			if err = data.Set("status_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundStatus.Id)
+			log.Println("success get status")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of status",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "description of status",
+			},
+			"category": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "status category, one of TODO, IN_PROGRESS or DONE",
+			},
+			"status_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of status",
+			},
+		},
+	}
+}
@@ -5,19 +5,61 @@ import (
 	"errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-hashicups-pf/datasources"
 	"terraform-provider-hashicups-pf/resources"
 	"terraform-provider-hashicups-pf/services/baseservice/models"
 )
 
 func Provider() *schema.Provider {
 	return &schema.Provider{
-		DataSourcesMap: map[string]*schema.Resource{},
+		DataSourcesMap: map[string]*schema.Resource{
+			"jiraserverfatih_status":                 datasources.StatusDataSource(),
+			"jiraserverfatih_priority":               datasources.PriorityDataSource(),
+			"jiraserverfatih_resolution":             datasources.ResolutionDataSource(),
+			"jiraserverfatih_issue_security_scheme":  datasources.IssueSecuritySchemeDataSource(),
+			"jiraserverfatih_issue_link_type":        datasources.IssueLinkTypeDataSource(),
+			"jiraserverfatih_board_configuration":    datasources.BoardConfigurationDataSource(),
+			"jiraserverfatih_application_properties": datasources.ApplicationPropertiesDataSource(),
+			"jiraserverfatih_permissionscheme":       datasources.PermissionSchemeDataSource(),
+			"jiraserverfatih_notification_scheme":    datasources.NotificationSchemeDataSource(),
+		},
 		ResourcesMap: map[string]*schema.Resource{
-			"jiraserverfatih_projectrole":      resources.ProjectRoleResource(),
-			"jiraserverfatih_group":            resources.GroupResource(),
-			"jiraserverfatih_permissionscheme": resources.PermissionSchemeResource(),
-			"jiraserverfatih_grant":            resources.GrantResource(),
-			"jiraserverfatih_issuetype":        resources.IssueTypeResource(),
+			"jiraserverfatih_projectrole":                   resources.ProjectRoleResource(),
+			"jiraserverfatih_group":                         resources.GroupResource(),
+			"jiraserverfatih_permissionscheme":              resources.PermissionSchemeResource(),
+			"jiraserverfatih_grant":                         resources.GrantResource(),
+			"jiraserverfatih_issuetype":                     resources.IssueTypeResource(),
+			"jiraserverfatih_issuetype_scheme":              resources.IssueTypeSchemeResource(),
+			"jiraserverfatih_avatar":                        resources.AvatarResource(),
+			"jiraserverfatih_customfield":                   resources.CustomFieldResource(),
+			"jiraserverfatih_customfield_context":           resources.CustomFieldContextResource(),
+			"jiraserverfatih_customfield_options":           resources.CustomFieldOptionsResource(),
+			"jiraserverfatih_field_configuration":           resources.FieldConfigurationResource(),
+			"jiraserverfatih_field_configuration_scheme":    resources.FieldConfigurationSchemeResource(),
+			"jiraserverfatih_workflow":                      resources.WorkflowResource(),
+			"jiraserverfatih_workflow_scheme":               resources.WorkflowSchemeResource(),
+			"jiraserverfatih_status":                        resources.StatusResource(),
+			"jiraserverfatih_priority":                      resources.PriorityResource(),
+			"jiraserverfatih_resolution":                    resources.ResolutionResource(),
+			"jiraserverfatih_notification_scheme":           resources.NotificationSchemeResource(),
+			"jiraserverfatih_issue_security_scheme":         resources.IssueSecuritySchemeResource(),
+			"jiraserverfatih_project_category":              resources.ProjectCategoryResource(),
+			"jiraserverfatih_project_component":             resources.ProjectComponentResource(),
+			"jiraserverfatih_project_version":               resources.ProjectVersionResource(),
+			"jiraserverfatih_issue_link_type":               resources.IssueLinkTypeResource(),
+			"jiraserverfatih_global_permission":             resources.GlobalPermissionResource(),
+			"jiraserverfatih_filter":                        resources.FilterResource(),
+			"jiraserverfatih_dashboard":                     resources.DashboardResource(),
+			"jiraserverfatih_board":                         resources.BoardResource(),
+			"jiraserverfatih_webhook":                       resources.WebhookResource(),
+			"jiraserverfatih_application_property":          resources.ApplicationPropertyResource(),
+			"jiraserverfatih_announcement_banner":           resources.AnnouncementBannerResource(),
+			"jiraserverfatih_project_property":              resources.ProjectPropertyResource(),
+			"jiraserverfatih_issuetype_property":            resources.IssueTypePropertyResource(),
+			"jiraserverfatih_application_role":              resources.ApplicationRoleResource(),
+			"jiraserverfatih_project_permission_scheme":     resources.ProjectPermissionSchemeResource(),
+			"jiraserverfatih_project_notification_scheme":   resources.ProjectNotificationSchemeResource(),
+			"jiraserverfatih_project_issue_security_scheme": resources.ProjectIssueSecuritySchemeResource(),
 		},
 		Schema: map[string]*schema.Schema{
 			"domain": &schema.Schema{
@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"terraform-provider-hashicups-pf/services/announcementbannerservice"
+	models2 "terraform-provider-hashicups-pf/services/announcementbannerservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+// the banner is a single instance setting, so every resource shares this id
+const announcementBannerId = "announcement-banner"
+
+func AnnouncementBannerResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			announcementBannerService := announcementbannerservice.AnnouncementBannerService{
+				JiraServerBase: client,
+			}
+
+			_, err := announcementBannerService.Update(ctx, models2.AnnouncementBannerUpdateRequestModel{
+				Message:       data.Get("message").(string),
+				Visibility:    data.Get("visibility").(string),
+				IsEnabled:     data.Get("enabled").(bool),
+				IsDismissible: data.Get("dismissible").(bool),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(announcementBannerId)
+			log.Println("success create announcement banner")
+			return AnnouncementBannerResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			announcementBannerService := announcementbannerservice.AnnouncementBannerService{
+				JiraServerBase: client,
+			}
+
+			foundBanner, err := announcementBannerService.Get(ctx, models2.AnnouncementBannerGetRequestModel{})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// jira server drops the message of a disabled banner, keep the configured one
+			if foundBanner.IsEnabled || foundBanner.Message != "" {
+				if err = data.Set("message", foundBanner.Message); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			if err = data.Set("visibility", foundBanner.Visibility); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("enabled", foundBanner.IsEnabled); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(announcementBannerId)
+			log.Println("success get announcement banner")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			announcementBannerService := announcementbannerservice.AnnouncementBannerService{
+				JiraServerBase: client,
+			}
+
+			_, err := announcementBannerService.Update(ctx, models2.AnnouncementBannerUpdateRequestModel{
+				Message:       data.Get("message").(string),
+				Visibility:    data.Get("visibility").(string),
+				IsEnabled:     data.Get("enabled").(bool),
+				IsDismissible: data.Get("dismissible").(bool),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update announcement banner")
+			return AnnouncementBannerResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			announcementBannerService := announcementbannerservice.AnnouncementBannerService{
+				JiraServerBase: client,
+			}
+
+			_, err := announcementBannerService.Update(ctx, models2.AnnouncementBannerUpdateRequestModel{
+				Message:    "",
+				Visibility: data.Get("visibility").(string),
+				IsEnabled:  false,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete announcement banner")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"message": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "html message of announcement banner",
+			},
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+				Description:  "visibility of announcement banner, public also shows it to anonymous users. valid values: public or private",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "whether the announcement banner is shown",
+			},
+			"dismissible": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "whether users can dismiss the announcement banner, ignored by jira versions without the banner rest api",
+			},
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/applicationpropertyservice"
+	models2 "terraform-provider-hashicups-pf/services/applicationpropertyservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func ApplicationPropertyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			key := data.Get("key").(string)
+			value := data.Get("value").(string)
+
+			applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := applicationPropertyService.Update(ctx, models2.ApplicationPropertyUpdateRequestModel{
+				Id:    key,
+				Value: value,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(key)
+			log.Println("success create application property")
+			return ApplicationPropertyResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+				JiraServerBase: client,
+			}
+
+			foundProperty, err := applicationPropertyService.Get(ctx, models2.ApplicationPropertyGetRequestModel{
+				Key: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("key", foundProperty.Key); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("value", foundProperty.Value); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("type", foundProperty.Type); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("default_value", foundProperty.DefaultValue); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("allowed_values", foundProperty.AllowedValues); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundProperty.Key)
+			log.Println("success get application property")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			value := data.Get("value").(string)
+
+			applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := applicationPropertyService.Update(ctx, models2.ApplicationPropertyUpdateRequestModel{
+				Id:    data.Id(),
+				Value: value,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update application property")
+			return ApplicationPropertyResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			// properties cannot be removed, destroying restores the jira default or only forgets the property without one
+			defaultValue := data.Get("default_value").(string)
+			if defaultValue != "" {
+				applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+					JiraServerBase: client,
+				}
+
+				_, err := applicationPropertyService.Update(ctx, models2.ApplicationPropertyUpdateRequestModel{
+					Id:    data.Id(),
+					Value: defaultValue,
+				})
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			data.SetId("")
+			log.Println("success delete application property")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if !diff.HasChange("value") || !diff.NewValueKnown("value") || !diff.NewValueKnown("key") {
+				return nil
+			}
+
+			applicationPropertyService := applicationpropertyservice.ApplicationPropertyService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+
+			key := diff.Get("key").(string)
+			foundProperty, err := applicationPropertyService.Get(ctx, models2.ApplicationPropertyGetRequestModel{
+				Key: key,
+			})
+			if err != nil {
+				return err
+			}
+
+			return validateApplicationPropertyValue(foundProperty, diff.Get("value").(string))
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of application property, e.g. jira.option.voting",
+			},
+			"value": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "value of application property, validated against the type and allowed values jira reports",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "type of application property",
+			},
+			"default_value": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "default value of application property, restored on destroy when jira reports one",
+			},
+			"allowed_values": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "allowed values of application property, empty when any value of its type is accepted",
+			},
+		},
+	}
+}
+
+func validateApplicationPropertyValue(property models2.ApplicationPropertyGetResponseModel, value string) error {
+	if len(property.AllowedValues) > 0 {
+		for _, allowed := range property.AllowedValues {
+			if allowed == value {
+				return nil
+			}
+		}
+		return errors.New("invalid value for " + property.Key + ", allowed values: " + strings.Join(property.AllowedValues, ", "))
+	}
+
+	switch property.Type {
+	case "boolean":
+		if value != "true" && value != "false" {
+			return errors.New("invalid value for " + property.Key + ", expected true or false")
+		}
+	case "number":
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return errors.New("invalid value for " + property.Key + ", expected a number")
+		}
+	}
+	return nil
+}
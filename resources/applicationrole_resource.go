@@ -0,0 +1,182 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"terraform-provider-hashicups-pf/services/applicationroleservice"
+	models2 "terraform-provider-hashicups-pf/services/applicationroleservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func ApplicationRoleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			key := data.Get("key").(string)
+
+			applicationRoleService := applicationroleservice.ApplicationRoleService{
+				JiraServerBase: client,
+			}
+
+			// application roles come with the installed applications, creating one takes over its group configuration
+			_, err := applicationRoleService.Update(ctx, models2.ApplicationRoleUpdateRequestModel{
+				Key:               key,
+				Groups:            interfaceListToStrings(data.Get("groups").(*schema.Set).List()),
+				DefaultGroups:     interfaceListToStrings(data.Get("default_groups").(*schema.Set).List()),
+				SelectedByDefault: data.Get("selected_by_default").(bool),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(key)
+			log.Println("success create application role")
+			return ApplicationRoleResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			applicationRoleService := applicationroleservice.ApplicationRoleService{
+				JiraServerBase: client,
+			}
+
+			foundRole, err := applicationRoleService.Get(ctx, models2.ApplicationRoleGetRequestModel{
+				Key: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("key", foundRole.Key); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("groups", foundRole.Groups); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("default_groups", foundRole.DefaultGroups); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("selected_by_default", foundRole.SelectedByDefault); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundRole.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("number_of_seats", foundRole.NumberOfSeats); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("remaining_seats", foundRole.RemainingSeats); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("user_count", foundRole.UserCount); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundRole.Key)
+			log.Println("success get application role")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			applicationRoleService := applicationroleservice.ApplicationRoleService{
+				JiraServerBase: client,
+			}
+
+			_, err := applicationRoleService.Update(ctx, models2.ApplicationRoleUpdateRequestModel{
+				Key:               data.Id(),
+				Groups:            interfaceListToStrings(data.Get("groups").(*schema.Set).List()),
+				DefaultGroups:     interfaceListToStrings(data.Get("default_groups").(*schema.Set).List()),
+				SelectedByDefault: data.Get("selected_by_default").(bool),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update application role")
+			return ApplicationRoleResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+
+			// clearing the groups would revoke everyone's license access, so destroy only forgets the role
+			data.SetId("")
+			log.Println("success delete application role")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if !diff.NewValueKnown("groups") || !diff.NewValueKnown("default_groups") {
+				return nil
+			}
+
+			groups := diff.Get("groups").(*schema.Set)
+			for _, group := range diff.Get("default_groups").(*schema.Set).List() {
+				if !groups.Contains(group) {
+					return errors.New("default group " + group.(string) + " must also be listed in groups")
+				}
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of application role, e.g. jira-software or jira-core",
+			},
+			"groups": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "names of groups granting access to the application",
+			},
+			"default_groups": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "names of groups new users of the application are added to, must be a subset of groups",
+			},
+			"selected_by_default": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether new users get access to the application by default",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "display name of application role",
+			},
+			"number_of_seats": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "licensed seats of application, -1 when unlimited",
+			},
+			"remaining_seats": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "remaining licensed seats of application",
+			},
+			"user_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "number of users with access to the application",
+			},
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package resources
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/avatarservice"
+	models2 "terraform-provider-hashicups-pf/services/avatarservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func AvatarResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			avatarType := data.Get("avatar_type").(string)
+			ownerId := data.Get("owner_id").(string)
+			filePath := data.Get("file_path").(string)
+
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			contentType, err := avatarContentType(filePath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			avatarService := avatarservice.AvatarService{
+				JiraServerBase: client,
+			}
+
+			createdAvatar, err := avatarService.Create(ctx, models2.AvatarCreateRequestModel{
+				Type:        avatarType,
+				OwnerId:     ownerId,
+				FileName:    filepath.Base(filePath),
+				ContentType: contentType,
+				Content:     content,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("content_sha256", avatarContentHash(content)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(createdAvatar.Id)
+			if err = data.Set("avatar_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdAvatar.Id)
+			log.Println("success create avatar")
+			return diags
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			avatarType := data.Get("avatar_type").(string)
+			ownerId := data.Get("owner_id").(string)
+
+			avatarService := avatarservice.AvatarService{
+				JiraServerBase: client,
+			}
+
+			foundAvatar, err := avatarService.Get(ctx, models2.AvatarGetRequestModel{
+				Type:    avatarType,
+				OwnerId: ownerId,
+				Id:      data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundAvatar.Id)
+			if err = data.Set("avatar_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundAvatar.Id)
+			log.Println("success get avatar")
+			return diags
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			avatarType := data.Get("avatar_type").(string)
+			ownerId := data.Get("owner_id").(string)
+
+			avatarService := avatarservice.AvatarService{
+				JiraServerBase: client,
+			}
+
+			_, err := avatarService.Delete(ctx, models2.AvatarDeleteRequestModel{
+				Type:    avatarType,
+				OwnerId: ownerId,
+				Id:      data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete avatar")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			filePath := diff.Get("file_path").(string)
+			if diff.Id() == "" || filePath == "" {
+				return nil
+			}
+
+			content, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			hash := avatarContentHash(content)
+			if diff.Get("content_sha256").(string) != hash {
+				if err = diff.SetNew("content_sha256", hash); err != nil {
+					return err
+				}
+				return diff.ForceNew("content_sha256")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"avatar_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"issuetype", "project"}, false),
+				Description:  "type of entity owning the avatar, valid values: issuetype or project",
+			},
+			"owner_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of the issue type or project the avatar is uploaded for",
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "path to a local png or svg file",
+			},
+			"content_sha256": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "sha256 of the uploaded file, a changed file replaces the avatar",
+			},
+			"avatar_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of avatar",
+			},
+		},
+	}
+}
+
+func avatarContentType(filePath string) (string, error) {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".png":
+		return "image/png", nil
+	case ".svg":
+		return "image/svg+xml", nil
+	}
+	return "", errors.New("avatar file must be a png or svg, got " + filePath)
+}
+
+func avatarContentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,146 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/agileservice"
+	models2 "terraform-provider-hashicups-pf/services/agileservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+func BoardResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			boardType := data.Get("type").(string)
+			filterId := data.Get("filter_id").(int)
+			projectKey := data.Get("project_key").(string)
+
+			agileService := agileservice.AgileService{
+				JiraServerBase: client,
+			}
+
+			request := models2.BoardCreateRequestModel{
+				Name:     name,
+				Type:     boardType,
+				FilterId: int64(filterId),
+			}
+			if projectKey != "" {
+				request.Location = &models2.BoardLocationApiRequestModel{
+					Type:           "project",
+					ProjectKeyOrId: projectKey,
+				}
+			}
+
+			createdBoard, err := agileService.CreateBoard(ctx, request)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(createdBoard.Id, 10))
+			log.Println("success create board")
+			return BoardResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			agileService := agileservice.AgileService{
+				JiraServerBase: client,
+			}
+
+			foundBoard, err := agileService.GetBoard(ctx, models2.BoardGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundBoard.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("type", foundBoard.Type); err != nil {
+				return diag.FromErr(err)
+			}
+
+			filterId, _ := strconv.Atoi(foundBoard.FilterId)
+			if err = data.Set("filter_id", filterId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", foundBoard.Location.ProjectKey); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("board_id", int(foundBoard.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundBoard.Id, 10))
+			log.Println("success get board")
+			return diags
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			agileService := agileservice.AgileService{
+				JiraServerBase: client,
+			}
+
+			_, err := agileService.DeleteBoard(ctx, models2.BoardDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete board")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "name of board, the agile api cannot rename boards so changes recreate it",
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"scrum", "kanban"}, false),
+				Description:  "type of board, valid values: scrum or kanban",
+			},
+			"filter_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of filter selecting the issues of board",
+			},
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "key of project the board is located in",
+			},
+			"board_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of board",
+			},
+		},
+	}
+}
@@ -0,0 +1,196 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/customfieldservice"
+	models2 "terraform-provider-hashicups-pf/services/customfieldservice/models"
+)
+
+func CustomFieldContextResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			projectIds := intListToStrings(data.Get("project_ids").(*schema.Set).List())
+			issueTypeIds := intListToStrings(data.Get("issue_type_ids").(*schema.Set).List())
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			createdContext, err := customFieldService.CreateContext(ctx, models2.CustomFieldContextCreateRequestModel{
+				FieldId:      fieldId,
+				Name:         name,
+				Description:  description,
+				ProjectIds:   projectIds,
+				IssueTypeIds: issueTypeIds,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdContext.Id)
+			log.Println("success create custom field context")
+			return CustomFieldContextResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			foundContext, err := customFieldService.GetContext(ctx, models2.CustomFieldContextGetRequestModel{
+				FieldId: fieldId,
+				Id:      data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundContext.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundContext.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_ids", stringsToIntList(foundContext.ProjectIds)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("issue_type_ids", stringsToIntList(foundContext.IssueTypeIds)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundContext.Id)
+			if err = data.Set("context_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundContext.Id)
+			log.Println("success get custom field context")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.UpdateContext(ctx, models2.CustomFieldContextUpdateRequestModel{
+				FieldId:     fieldId,
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update custom field context")
+			return CustomFieldContextResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.DeleteContext(ctx, models2.CustomFieldContextDeleteRequestModel{
+				FieldId: fieldId,
+				Id:      data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete custom field context")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if diff.Id() != "" && !diff.HasChanges("name", "description", "project_ids", "issue_type_ids") {
+				return nil
+			}
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+			return customFieldService.CheckContextSupport(ctx)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) ([]*schema.ResourceData, error) {
+				parts := strings.Split(data.Id(), "/")
+				if len(parts) != 2 {
+					return nil, errors.New("import id must be <field_id>/<context_id>")
+				}
+				if err := data.Set("field_id", parts[0]); err != nil {
+					return nil, err
+				}
+				data.SetId(parts[1])
+				return []*schema.ResourceData{data}, nil
+			},
+		},
+		Schema: map[string]*schema.Schema{
+			"field_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of custom field, e.g. customfield_10100",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of custom field context",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of custom field context",
+			},
+			"project_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "ids of projects the context applies to, empty means global context",
+			},
+			"issue_type_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "ids of issue types the context applies to, empty means any issue type",
+			},
+			"context_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of custom field context",
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/customfieldservice"
+	models2 "terraform-provider-hashicups-pf/services/customfieldservice/models"
+)
+
+func CustomFieldOptionsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			data.SetId(strconv.Itoa(data.Get("context_id").(int)))
+			return CustomFieldOptionsResource().UpdateContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			foundOptions, err := customFieldService.ListOptions(ctx, models2.CustomFieldOptionListRequestModel{
+				FieldId:   fieldId,
+				ContextId: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("option", flattenCustomFieldOptions(foundOptions.Values)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get custom field options")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+			options := []models2.CustomFieldOptionModel{}
+			for _, raw := range data.Get("option").([]interface{}) {
+				option := raw.(map[string]interface{})
+				options = append(options, models2.CustomFieldOptionModel{
+					Value:    option["value"].(string),
+					Disabled: option["disabled"].(bool),
+				})
+			}
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.SetOptions(ctx, models2.CustomFieldOptionSetRequestModel{
+				FieldId:   fieldId,
+				ContextId: data.Id(),
+				Options:   options,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success set custom field options")
+			return CustomFieldOptionsResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldId := data.Get("field_id").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.SetOptions(ctx, models2.CustomFieldOptionSetRequestModel{
+				FieldId:   fieldId,
+				ContextId: data.Id(),
+				Options:   []models2.CustomFieldOptionModel{},
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete custom field options")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if diff.Id() != "" && !diff.HasChanges("option") {
+				return nil
+			}
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+			return customFieldService.CheckContextSupport(ctx)
+		},
+		Schema: map[string]*schema.Schema{
+			"field_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of custom field, e.g. customfield_10100",
+			},
+			"context_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of custom field context owning the options",
+			},
+			"option": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "ordered options of the custom field context",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "value of option",
+						},
+						"disabled": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether the option is disabled",
+						},
+						"option_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "id of option",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenCustomFieldOptions(options []models2.CustomFieldOptionModel) []interface{} {
+	result := []interface{}{}
+	for _, option := range options {
+		result = append(result, map[string]interface{}{
+			"value":     option.Value,
+			"disabled":  option.Disabled,
+			"option_id": option.Id,
+		})
+	}
+	return result
+}
@@ -0,0 +1,164 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/customfieldservice"
+	models2 "terraform-provider-hashicups-pf/services/customfieldservice/models"
+)
+
+func CustomFieldResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			fieldType := data.Get("type").(string)
+			searcherKey := data.Get("searcher_key").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			createdField, err := customFieldService.Create(ctx, models2.CustomFieldCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Type:        fieldType,
+				SearcherKey: searcherKey,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", createdField.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("field_id", createdField.Id); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdField.Id)
+			log.Println("success create custom field")
+			return diags
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			foundField, err := customFieldService.Get(ctx, models2.CustomFieldGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundField.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			// jira server does not return the description in the field list, keep the configured one
+			if foundField.Description != "" {
+				if err = data.Set("description", foundField.Description); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			if err = data.Set("type", foundField.Schema.Custom); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("field_id", foundField.Id); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundField.Id)
+			log.Println("success get custom field")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			searcherKey := data.Get("searcher_key").(string)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.Update(ctx, models2.CustomFieldUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+				SearcherKey: searcherKey,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update custom field")
+			return CustomFieldResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			customFieldService := customfieldservice.CustomFieldService{
+				JiraServerBase: client,
+			}
+
+			_, err := customFieldService.Delete(ctx, models2.CustomFieldDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete custom field")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of custom field",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of custom field",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "custom field type key, e.g. com.atlassian.jira.plugin.system.customfieldtypes:select",
+			},
+			"searcher_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "searcher key, e.g. com.atlassian.jira.plugin.system.customfieldtypes:multiselectsearcher",
+			},
+			"field_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "id of custom field, e.g. customfield_10100",
+			},
+		},
+	}
+}
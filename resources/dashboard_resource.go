@@ -0,0 +1,215 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/dashboardservice"
+	models2 "terraform-provider-hashicups-pf/services/dashboardservice/models"
+)
+
+func DashboardResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			shares := expandDashboardShares(data.Get("share").(*schema.Set).List())
+
+			dashboardService := dashboardservice.DashboardService{
+				JiraServerBase: client,
+			}
+
+			createdDashboard, err := dashboardService.Create(ctx, models2.DashboardCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Shares:      shares,
+			})
+			if createdDashboard.Id != "" {
+				data.SetId(createdDashboard.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create dashboard")
+			return DashboardResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			dashboardService := dashboardservice.DashboardService{
+				JiraServerBase: client,
+			}
+
+			foundDashboard, err := dashboardService.Get(ctx, models2.DashboardGetRequestModel{
+				Id: data.Id(),
+			})
+			if errors.Is(err, dashboardservice.ErrDashboardNotFound) {
+				// removed outside terraform, let the plan recreate it
+				log.Println("dashboard not found, removing from state")
+				data.SetId("")
+				return diags
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundDashboard.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundDashboard.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			shares := []interface{}{}
+			for _, share := range foundDashboard.Shares {
+				projectId, _ := strconv.Atoi(share.ProjectId)
+				projectRoleId, _ := strconv.Atoi(share.ProjectRoleId)
+				shares = append(shares, map[string]interface{}{
+					"type":            share.Type,
+					"group_name":      share.GroupName,
+					"project_id":      projectId,
+					"project_role_id": projectRoleId,
+				})
+			}
+			if err = data.Set("share", shares); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundDashboard.Id)
+			if err = data.Set("dashboard_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundDashboard.Id)
+			log.Println("success get dashboard")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			shares := expandDashboardShares(data.Get("share").(*schema.Set).List())
+
+			dashboardService := dashboardservice.DashboardService{
+				JiraServerBase: client,
+			}
+
+			_, err := dashboardService.Update(ctx, models2.DashboardUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+				Shares:      shares,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update dashboard")
+			return DashboardResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			dashboardService := dashboardservice.DashboardService{
+				JiraServerBase: client,
+			}
+
+			_, err := dashboardService.Delete(ctx, models2.DashboardDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete dashboard")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if diff.Id() != "" && !diff.HasChanges("name", "description", "share") {
+				return nil
+			}
+
+			dashboardService := dashboardservice.DashboardService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+			return dashboardService.CheckSupport(ctx)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of dashboard. layout and gadgets are out of scope, jira server has no rest api to manage them",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of dashboard",
+			},
+			"share": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "share permissions of dashboard",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"group", "project", "projectRole", "global", "loggedin"}, false),
+							Description:  "share type, valid values: group, project, projectRole, global or loggedin",
+						},
+						"group_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "name of group, for group shares",
+						},
+						"project_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "id of project, for project and projectRole shares",
+						},
+						"project_role_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "id of project role, for projectRole shares",
+						},
+					},
+				},
+			},
+			"dashboard_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of dashboard",
+			},
+		},
+	}
+}
+
+func expandDashboardShares(shares []interface{}) []models2.DashboardShareModel {
+	result := []models2.DashboardShareModel{}
+	for _, raw := range shares {
+		share := raw.(map[string]interface{})
+		result = append(result, models2.DashboardShareModel{
+			Type:          share["type"].(string),
+			GroupName:     share["group_name"].(string),
+			ProjectId:     optionalIdToString(share["project_id"].(int)),
+			ProjectRoleId: optionalIdToString(share["project_role_id"].(int)),
+		})
+	}
+	return result
+}
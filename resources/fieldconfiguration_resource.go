@@ -0,0 +1,220 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/fieldconfigurationservice"
+	models2 "terraform-provider-hashicups-pf/services/fieldconfigurationservice/models"
+)
+
+func FieldConfigurationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			items := expandFieldConfigurationItems(data.Get("field").(*schema.Set).List())
+
+			fieldConfigurationService := fieldconfigurationservice.FieldConfigurationService{
+				JiraServerBase: client,
+			}
+
+			createdFieldConfiguration, err := fieldConfigurationService.Create(ctx, models2.FieldConfigurationCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Items:       items,
+			})
+			if createdFieldConfiguration.Id != 0 {
+				data.SetId(strconv.FormatInt(createdFieldConfiguration.Id, 10))
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create field configuration")
+			return FieldConfigurationResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			managedFields := map[string]bool{}
+			for _, item := range expandFieldConfigurationItems(data.Get("field").(*schema.Set).List()) {
+				managedFields[item.Id] = true
+			}
+
+			fieldConfigurationService := fieldconfigurationservice.FieldConfigurationService{
+				JiraServerBase: client,
+			}
+
+			foundFieldConfiguration, err := fieldConfigurationService.Get(ctx, models2.FieldConfigurationGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundFieldConfiguration.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundFieldConfiguration.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			// only fields listed in the configuration are tracked, the rest keep jira's defaults
+			fields := []interface{}{}
+			for _, item := range foundFieldConfiguration.Items {
+				if managedFields[item.Id] {
+					fields = append(fields, map[string]interface{}{
+						"field_id":    item.Id,
+						"required":    item.IsRequired,
+						"hidden":      item.IsHidden,
+						"renderer":    item.Renderer,
+						"description": item.Description,
+					})
+				}
+			}
+			if err = data.Set("field", fields); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("field_configuration_id", int(foundFieldConfiguration.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundFieldConfiguration.Id, 10))
+			log.Println("success get field configuration")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			oldFields, newFields := data.GetChange("field")
+			items := expandFieldConfigurationItems(newFields.(*schema.Set).List())
+
+			wanted := map[string]bool{}
+			for _, item := range items {
+				wanted[item.Id] = true
+			}
+			removedFields := []string{}
+			for _, item := range expandFieldConfigurationItems(oldFields.(*schema.Set).List()) {
+				if !wanted[item.Id] {
+					removedFields = append(removedFields, item.Id)
+				}
+			}
+
+			fieldConfigurationService := fieldconfigurationservice.FieldConfigurationService{
+				JiraServerBase: client,
+			}
+
+			_, err := fieldConfigurationService.Update(ctx, models2.FieldConfigurationUpdateRequestModel{
+				Id:            data.Id(),
+				Name:          name,
+				Description:   description,
+				Items:         items,
+				RemovedFields: removedFields,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update field configuration")
+			return FieldConfigurationResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldConfigurationService := fieldconfigurationservice.FieldConfigurationService{
+				JiraServerBase: client,
+			}
+
+			_, err := fieldConfigurationService.Delete(ctx, models2.FieldConfigurationDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete field configuration")
+			return diags
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of field configuration",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of field configuration",
+			},
+			"field": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "per field settings, fields not listed keep jira's defaults",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "id of field, e.g. summary or customfield_10100",
+						},
+						"required": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether the field is required",
+						},
+						"hidden": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether the field is hidden",
+						},
+						"renderer": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "renderer of field, e.g. wiki-renderer or jira-text-renderer",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "description of field in this configuration",
+						},
+					},
+				},
+			},
+			"field_configuration_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of field configuration",
+			},
+		},
+	}
+}
+
+func expandFieldConfigurationItems(fields []interface{}) []models2.FieldConfigurationItemModel {
+	items := []models2.FieldConfigurationItemModel{}
+	for _, raw := range fields {
+		field := raw.(map[string]interface{})
+		items = append(items, models2.FieldConfigurationItemModel{
+			Id:          field["field_id"].(string),
+			IsRequired:  field["required"].(bool),
+			IsHidden:    field["hidden"].(bool),
+			Renderer:    field["renderer"].(string),
+			Description: field["description"].(string),
+		})
+	}
+	return items
+}
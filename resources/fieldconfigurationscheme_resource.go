@@ -0,0 +1,194 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/fieldconfigurationschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/fieldconfigurationschemeservice/models"
+)
+
+func FieldConfigurationSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			mappings := expandFieldConfigurationSchemeMappings(data.Get("mapping").(*schema.Set).List())
+
+			fieldConfigurationSchemeService := fieldconfigurationschemeservice.FieldConfigurationSchemeService{
+				JiraServerBase: client,
+			}
+
+			createdScheme, err := fieldConfigurationSchemeService.Create(ctx, models2.FieldConfigurationSchemeCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Mappings:    mappings,
+			})
+			if createdScheme.Id != "" {
+				data.SetId(createdScheme.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create field configuration scheme")
+			return FieldConfigurationSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldConfigurationSchemeService := fieldconfigurationschemeservice.FieldConfigurationSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := fieldConfigurationSchemeService.Get(ctx, models2.FieldConfigurationSchemeGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundScheme.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			mappings := []interface{}{}
+			for _, mapping := range foundScheme.Mappings {
+				fieldConfigurationId, _ := strconv.Atoi(mapping.FieldConfigurationId)
+				mappings = append(mappings, map[string]interface{}{
+					"issue_type_id":          mapping.IssueTypeId,
+					"field_configuration_id": fieldConfigurationId,
+				})
+			}
+			if err = data.Set("mapping", mappings); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundScheme.Id)
+			if err = data.Set("field_configuration_scheme_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundScheme.Id)
+			log.Println("success get field configuration scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			oldMappings, newMappings := data.GetChange("mapping")
+			mappings := expandFieldConfigurationSchemeMappings(newMappings.(*schema.Set).List())
+
+			wanted := map[string]bool{}
+			for _, mapping := range mappings {
+				wanted[mapping.IssueTypeId] = true
+			}
+			removedIssueTypeIds := []string{}
+			for _, mapping := range expandFieldConfigurationSchemeMappings(oldMappings.(*schema.Set).List()) {
+				if !wanted[mapping.IssueTypeId] {
+					removedIssueTypeIds = append(removedIssueTypeIds, mapping.IssueTypeId)
+				}
+			}
+
+			fieldConfigurationSchemeService := fieldconfigurationschemeservice.FieldConfigurationSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := fieldConfigurationSchemeService.Update(ctx, models2.FieldConfigurationSchemeUpdateRequestModel{
+				Id:                  data.Id(),
+				Name:                name,
+				Description:         description,
+				Mappings:            mappings,
+				RemovedIssueTypeIds: removedIssueTypeIds,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update field configuration scheme")
+			return FieldConfigurationSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			fieldConfigurationSchemeService := fieldconfigurationschemeservice.FieldConfigurationSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := fieldConfigurationSchemeService.Delete(ctx, models2.FieldConfigurationSchemeDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete field configuration scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of field configuration scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of field configuration scheme",
+			},
+			"mapping": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "issue type to field configuration mappings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issue_type_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "id of issue type, or default for all unmapped issue types",
+						},
+						"field_configuration_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "id of field configuration",
+						},
+					},
+				},
+			},
+			"field_configuration_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of field configuration scheme",
+			},
+		},
+	}
+}
+
+func expandFieldConfigurationSchemeMappings(mappings []interface{}) []models2.FieldConfigurationSchemeMappingModel {
+	result := []models2.FieldConfigurationSchemeMappingModel{}
+	for _, raw := range mappings {
+		mapping := raw.(map[string]interface{})
+		result = append(result, models2.FieldConfigurationSchemeMappingModel{
+			IssueTypeId:          mapping["issue_type_id"].(string),
+			FieldConfigurationId: strconv.Itoa(mapping["field_configuration_id"].(int)),
+		})
+	}
+	return result
+}
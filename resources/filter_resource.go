@@ -0,0 +1,249 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/filterservice"
+	models2 "terraform-provider-hashicups-pf/services/filterservice/models"
+)
+
+func FilterResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			jql := data.Get("jql").(string)
+			favourite := data.Get("favourite").(bool)
+			shares := expandFilterShares(data.Get("share").(*schema.Set).List())
+
+			filterService := filterservice.FilterService{
+				JiraServerBase: client,
+			}
+
+			createdFilter, err := filterService.Create(ctx, models2.FilterCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Jql:         jql,
+				Favourite:   favourite,
+				Shares:      shares,
+			})
+			if createdFilter.Id != "" {
+				data.SetId(createdFilter.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create filter")
+			return FilterResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			filterService := filterservice.FilterService{
+				JiraServerBase: client,
+			}
+
+			foundFilter, err := filterService.Get(ctx, models2.FilterGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundFilter.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundFilter.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("jql", foundFilter.Jql); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("favourite", foundFilter.Favourite); err != nil {
+				return diag.FromErr(err)
+			}
+
+			shares := []interface{}{}
+			for _, share := range foundFilter.Shares {
+				projectId, _ := strconv.Atoi(share.ProjectId)
+				projectRoleId, _ := strconv.Atoi(share.ProjectRoleId)
+				shares = append(shares, map[string]interface{}{
+					"type":            share.Type,
+					"group_name":      share.GroupName,
+					"project_id":      projectId,
+					"project_role_id": projectRoleId,
+				})
+			}
+			if err = data.Set("share", shares); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundFilter.Id)
+			if err = data.Set("filter_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundFilter.Id)
+			log.Println("success get filter")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			jql := data.Get("jql").(string)
+			favourite := data.Get("favourite").(bool)
+			shares := expandFilterShares(data.Get("share").(*schema.Set).List())
+
+			filterService := filterservice.FilterService{
+				JiraServerBase: client,
+			}
+
+			_, err := filterService.Update(ctx, models2.FilterUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+				Jql:         jql,
+				Favourite:   favourite,
+				Shares:      shares,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update filter")
+			return FilterResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			filterService := filterservice.FilterService{
+				JiraServerBase: client,
+			}
+
+			_, err := filterService.Delete(ctx, models2.FilterDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete filter")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if !diff.HasChange("jql") || !diff.NewValueKnown("jql") {
+				return nil
+			}
+
+			filterService := filterservice.FilterService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+
+			validated, err := filterService.ValidateJql(ctx, models2.FilterValidateJqlRequestModel{
+				Jql: diff.Get("jql").(string),
+			})
+			if err != nil {
+				// validation is best effort, jira still rejects bad jql on apply
+				log.Println("skipping jql validation: " + err.Error())
+				return nil
+			}
+			if len(validated.Errors) > 0 {
+				return errors.New("invalid jql: " + strings.Join(validated.Errors, "; "))
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of filter",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of filter",
+			},
+			"jql": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "jql query of filter, validated against jira during plan",
+			},
+			"favourite": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether the filter is a favourite of the token user",
+			},
+			"share": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "share permissions of filter",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"group", "project", "projectRole", "global", "loggedin"}, false),
+							Description:  "share type, valid values: group, project, projectRole, global or loggedin",
+						},
+						"group_name": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "name of group, for group shares",
+						},
+						"project_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "id of project, for project and projectRole shares",
+						},
+						"project_role_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "id of project role, for projectRole shares",
+						},
+					},
+				},
+			},
+			"filter_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of filter",
+			},
+		},
+	}
+}
+
+func expandFilterShares(shares []interface{}) []models2.FilterShareModel {
+	result := []models2.FilterShareModel{}
+	for _, raw := range shares {
+		share := raw.(map[string]interface{})
+		result = append(result, models2.FilterShareModel{
+			Type:          share["type"].(string),
+			GroupName:     share["group_name"].(string),
+			ProjectId:     optionalIdToString(share["project_id"].(int)),
+			ProjectRoleId: optionalIdToString(share["project_role_id"].(int)),
+		})
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/globalpermissionservice"
+	models2 "terraform-provider-hashicups-pf/services/globalpermissionservice/models"
+)
+
+func GlobalPermissionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			permission := data.Get("permission").(string)
+			groupName := data.Get("group_name").(string)
+
+			globalPermissionService := globalpermissionservice.GlobalPermissionService{
+				JiraServerBase: client,
+			}
+
+			createdPermission, err := globalPermissionService.Create(ctx, models2.GlobalPermissionCreateRequestModel{
+				Permission: permission,
+				GroupName:  groupName,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdPermission.Permission + "/" + createdPermission.GroupName)
+			log.Println("success create global permission")
+			return GlobalPermissionResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			permission, groupName, found := strings.Cut(data.Id(), "/")
+			if !found {
+				return diag.FromErr(errors.New("invalid global permission id " + data.Id() + ", expected <permission>/<group_name>"))
+			}
+
+			globalPermissionService := globalpermissionservice.GlobalPermissionService{
+				JiraServerBase: client,
+			}
+
+			foundPermission, err := globalPermissionService.Get(ctx, models2.GlobalPermissionGetRequestModel{
+				Permission: permission,
+				GroupName:  groupName,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// the grant was removed outside of terraform, plan it again
+			if foundPermission.Permission == "" {
+				log.Println("global permission no longer granted")
+				data.SetId("")
+				return diags
+			}
+
+			if err = data.Set("permission", foundPermission.Permission); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("group_name", foundPermission.GroupName); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get global permission")
+			return diags
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			permission := data.Get("permission").(string)
+			groupName := data.Get("group_name").(string)
+
+			globalPermissionService := globalpermissionservice.GlobalPermissionService{
+				JiraServerBase: client,
+			}
+
+			_, err := globalPermissionService.Delete(ctx, models2.GlobalPermissionDeleteRequestModel{
+				Permission: permission,
+				GroupName:  groupName,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete global permission")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"permission": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ADMINISTER", "SYSTEM_ADMIN", "USER_PICKER", "CREATE_SHARED_OBJECTS",
+					"MANAGE_GROUP_FILTER_SUBSCRIPTIONS", "BULK_CHANGE",
+				}, false),
+				Description: "key of global permission, e.g. ADMINISTER, SYSTEM_ADMIN, USER_PICKER or CREATE_SHARED_OBJECTS",
+			},
+			"group_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "name of group the global permission is granted to",
+			},
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
+	"strings"
 	"terraform-provider-hashicups-pf/services/baseservice/models"
 	"terraform-provider-hashicups-pf/services/grantservice"
 	models2 "terraform-provider-hashicups-pf/services/grantservice/models"
@@ -69,85 +70,26 @@ func GrantResource() *schema.Resource {
 			client := i.(models.JiraServerBase)
 
 			permissionSchemeId := data.Get("permission_scheme_id").(int)
-			permissionName := data.Get("permission_name").(string)
-			holderType := data.Get("security_type").(string)
-			holderParam := data.Get("security_param").(int)
-			grantId := data.Get("grant_id").(int)
-
-			grantService := grantservice.GrantService{
-				JiraServerBase: client,
-			}
-
-			foundGrant, err := grantService.Get(ctx, models2.GrantGetRequestModel{
-				Permission:         permissionName,
-				PermissionSchemeId: int64(permissionSchemeId),
-				Holder: models2.GrantHolderModel{
-					Type:      holderType,
-					Parameter: strconv.Itoa(holderParam),
-				},
-			})
-
+			grantId, err := strconv.ParseInt(data.Id(), 10, 64)
 			if err != nil {
-				return diag.FromErr(err)
-			}
-
-			if err = data.Set("permission_scheme_id", foundGrant.PermissionSchemeId); err != nil {
-				return diag.FromErr(err)
-			}
-
-			if err = data.Set("permission_name", foundGrant.Permission); err != nil {
-				return diag.FromErr(err)
-			}
-
-			if err = data.Set("security_type", foundGrant.Holder.Type); err != nil {
-				return diag.FromErr(err)
-			}
-
-			param, _ := strconv.Atoi(foundGrant.Holder.Parameter)
-			if err = data.Set("security_param", param); err != nil {
-				return diag.FromErr(err)
+				return diag.FromErr(errors.New("invalid grant id " + data.Id()))
 			}
 
-			if grantId != int(foundGrant.Id) {
-				return diag.FromErr(errors.New("created & fetched grant id inconsistent"))
-			}
-
-			if err = data.Set("grant_id", int(foundGrant.Id)); err != nil {
-				return diag.FromErr(err)
-			}
-
-			data.SetId(strconv.FormatInt(foundGrant.Id, 10))
-			log.Println("success get grant")
-			return diags
-		},
-		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
-			var diags diag.Diagnostics
-			client := i.(models.JiraServerBase)
-
-			grantId := data.Get("grant_id").(int)
-			permissionSchemeId := data.Get("permission_scheme_id").(int)
-			permissionName := data.Get("permission_name").(string)
-			holderType := data.Get("security_type").(string)
-			holderParam := data.Get("security_param").(int)
-
 			grantService := grantservice.GrantService{
 				JiraServerBase: client,
 			}
 
 			foundGrant, err := grantService.Get(ctx, models2.GrantGetRequestModel{
-				Permission:         permissionName,
+				Id:                 grantId,
 				PermissionSchemeId: int64(permissionSchemeId),
-				Holder: models2.GrantHolderModel{
-					Type:      holderType,
-					Parameter: strconv.Itoa(holderParam),
-				},
 			})
-
-			if err != nil {
-				return diag.FromErr(err)
+			if errors.Is(err, grantservice.ErrGrantNotFound) {
+				// removed outside terraform, let the plan recreate it
+				log.Println("grant not found, removing from state")
+				data.SetId("")
+				return diags
 			}
-
-			if err = data.Set("permission_scheme_id", int(foundGrant.PermissionSchemeId)); err != nil {
+			if err != nil {
 				return diag.FromErr(err)
 			}
 
@@ -164,16 +106,11 @@ func GrantResource() *schema.Resource {
 				return diag.FromErr(err)
 			}
 
-			if grantId != int(foundGrant.Id) {
-				return diag.FromErr(errors.New("created & fetched grant id inconsistent"))
-			}
-
 			if err = data.Set("grant_id", int(foundGrant.Id)); err != nil {
 				return diag.FromErr(err)
 			}
 
-			data.SetId(strconv.FormatInt(foundGrant.Id, 10))
-			log.Println("success update grant")
+			log.Println("success get grant")
 			return diags
 		},
 		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -190,6 +127,7 @@ func GrantResource() *schema.Resource {
 			}
 
 			_, err := grantService.Delete(ctx, models2.GrantDeleteRequestModel{
+				Id:                 int64(data.Get("grant_id").(int)),
 				Permission:         permissionName,
 				PermissionSchemeId: int64(permissionSchemeId),
 				Holder: models2.GrantHolderModel{
@@ -213,21 +151,29 @@ func GrantResource() *schema.Resource {
 			"permission_scheme_id": &schema.Schema{
 				Type:        schema.TypeInt,
 				Required:    true,
+				ForceNew:    true,
 				Description: "id of target permission scheme",
 			},
 			"permission_name": &schema.Schema{
 				Type:        schema.TypeString,
 				Required:    true,
+				ForceNew:    true,
 				Description: "name of permission name to be granted",
 			},
 			"security_type": &schema.Schema{
-				Type:        schema.TypeString,
-				Required:    true,
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					// jira reports projectRole for a grant created as projectrole
+					return strings.EqualFold(oldValue, newValue)
+				},
 				Description: "name of security type, e.g. projectrole",
 			},
 			"security_param": &schema.Schema{
 				Type:        schema.TypeInt,
 				Required:    true,
+				ForceNew:    true,
 				Description: "value of security type input",
 			},
 		},
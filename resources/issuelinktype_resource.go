@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuelinktypeservice"
+	models2 "terraform-provider-hashicups-pf/services/issuelinktypeservice/models"
+)
+
+func IssueLinkTypeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			inward := data.Get("inward").(string)
+			outward := data.Get("outward").(string)
+
+			issueLinkTypeService := issuelinktypeservice.IssueLinkTypeService{
+				JiraServerBase: client,
+			}
+
+			createdLinkType, err := issueLinkTypeService.Create(ctx, models2.IssueLinkTypeCreateRequestModel{
+				Name:    name,
+				Inward:  inward,
+				Outward: outward,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdLinkType.Id)
+			log.Println("success create issue link type")
+			return IssueLinkTypeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueLinkTypeService := issuelinktypeservice.IssueLinkTypeService{
+				JiraServerBase: client,
+			}
+
+			foundLinkType, err := issueLinkTypeService.Get(ctx, models2.IssueLinkTypeGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundLinkType.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("inward", foundLinkType.Inward); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("outward", foundLinkType.Outward); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundLinkType.Id)
+			if err = data.Set("issue_link_type_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundLinkType.Id)
+			log.Println("success get issue link type")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			inward := data.Get("inward").(string)
+			outward := data.Get("outward").(string)
+
+			issueLinkTypeService := issuelinktypeservice.IssueLinkTypeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueLinkTypeService.Update(ctx, models2.IssueLinkTypeUpdateRequestModel{
+				Id:      data.Id(),
+				Name:    name,
+				Inward:  inward,
+				Outward: outward,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update issue link type")
+			return IssueLinkTypeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueLinkTypeService := issuelinktypeservice.IssueLinkTypeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueLinkTypeService.Delete(ctx, models2.IssueLinkTypeDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete issue link type")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of issue link type",
+			},
+			"inward": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "inward description of issue link type, e.g. is blocked by",
+			},
+			"outward": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "outward description of issue link type, e.g. blocks",
+			},
+			"issue_link_type_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of issue link type",
+			},
+		},
+	}
+}
@@ -0,0 +1,256 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuesecurityschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/issuesecurityschemeservice/models"
+)
+
+func IssueSecuritySchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			levels := expandIssueSecurityLevels(data.Get("level").([]interface{}))
+
+			issueSecuritySchemeService := issuesecurityschemeservice.IssueSecuritySchemeService{
+				JiraServerBase: client,
+			}
+
+			createdScheme, err := issueSecuritySchemeService.Create(ctx, models2.IssueSecuritySchemeCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Levels:      levels,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdScheme.Id)
+			log.Println("success create issue security scheme")
+			return IssueSecuritySchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueSecuritySchemeService := issuesecurityschemeservice.IssueSecuritySchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := issueSecuritySchemeService.Get(ctx, models2.IssueSecuritySchemeGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundScheme.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("level", flattenIssueSecurityLevels(foundScheme.Levels, data.Get("level").([]interface{}))); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("issue_security_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundScheme.Id, 10))
+			log.Println("success get issue security scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			levels := expandIssueSecurityLevels(data.Get("level").([]interface{}))
+
+			issueSecuritySchemeService := issuesecurityschemeservice.IssueSecuritySchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueSecuritySchemeService.Update(ctx, models2.IssueSecuritySchemeUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+				Levels:      levels,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update issue security scheme")
+			return IssueSecuritySchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueSecuritySchemeService := issuesecurityschemeservice.IssueSecuritySchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueSecuritySchemeService.Delete(ctx, models2.IssueSecuritySchemeDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete issue security scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of issue security scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of issue security scheme",
+			},
+			"level": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "security levels of the scheme, matched by name",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "name of security level",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "description of security level",
+						},
+						"default": &schema.Schema{
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "whether this is the default security level of the scheme",
+						},
+						"member": &schema.Schema{
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "who can see issues with this security level",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"group", "projectRole", "user", "reporter", "assignee",
+											"applicationRole", "projectLead", "userCustomField", "groupCustomField",
+										}, false),
+										Description: "name of member type, e.g. group, projectRole, user or reporter",
+									},
+									"parameter": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "value of member type input, e.g. group name, project role id or user key",
+									},
+								},
+							},
+						},
+						"level_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "id of security level",
+						},
+					},
+				},
+			},
+			"issue_security_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of issue security scheme",
+			},
+		},
+	}
+}
+
+func expandIssueSecurityLevels(levels []interface{}) []models2.IssueSecurityLevelModel {
+	result := []models2.IssueSecurityLevelModel{}
+	for _, raw := range levels {
+		level := raw.(map[string]interface{})
+		members := []models2.IssueSecurityHolderModel{}
+		for _, rawMember := range level["member"].(*schema.Set).List() {
+			member := rawMember.(map[string]interface{})
+			members = append(members, models2.IssueSecurityHolderModel{
+				Type:      member["type"].(string),
+				Parameter: member["parameter"].(string),
+			})
+		}
+		result = append(result, models2.IssueSecurityLevelModel{
+			Name:        level["name"].(string),
+			Description: level["description"].(string),
+			IsDefault:   level["default"].(bool),
+			Members:     members,
+		})
+	}
+	return result
+}
+
+// flattenIssueSecurityLevels keeps the configured level order, levels only known to jira go last.
+func flattenIssueSecurityLevels(levels []models2.IssueSecurityLevelModel, configured []interface{}) []interface{} {
+	position := map[string]int{}
+	for index, raw := range configured {
+		position[raw.(map[string]interface{})["name"].(string)] = index
+	}
+
+	ordered := make([]interface{}, len(configured))
+	extra := []interface{}{}
+	for _, level := range levels {
+		members := []interface{}{}
+		for _, member := range level.Members {
+			members = append(members, map[string]interface{}{
+				"type":      member.Type,
+				"parameter": member.Parameter,
+			})
+		}
+		levelId, _ := strconv.Atoi(level.Id)
+		flattened := map[string]interface{}{
+			"name":        level.Name,
+			"description": level.Description,
+			"default":     level.IsDefault,
+			"member":      members,
+			"level_id":    levelId,
+		}
+		if index, ok := position[level.Name]; ok && ordered[index] == nil {
+			ordered[index] = flattened
+		} else {
+			extra = append(extra, flattened)
+		}
+	}
+
+	result := []interface{}{}
+	for _, level := range ordered {
+		if level != nil {
+			result = append(result, level)
+		}
+	}
+	return append(result, extra...)
+}
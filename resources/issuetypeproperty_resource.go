@@ -0,0 +1,154 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/entitypropertyservice"
+	models2 "terraform-provider-hashicups-pf/services/entitypropertyservice/models"
+)
+
+func IssueTypePropertyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			issueTypeId := strconv.Itoa(data.Get("issue_type_id").(int))
+			key := data.Get("key").(string)
+			value := data.Get("value").(string)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Set(ctx, models2.EntityPropertySetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeIssueType,
+				EntityId:   issueTypeId,
+				Key:        key,
+				Value:      value,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(issueTypeId + "/" + key)
+			log.Println("success create issue type property")
+			return IssueTypePropertyResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueTypeId, key, found := strings.Cut(data.Id(), "/")
+			if !found {
+				return diag.FromErr(errors.New("invalid issue type property id " + data.Id() + ", expected <issue_type_id>/<property_key>"))
+			}
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			foundProperty, err := entityPropertyService.Get(ctx, models2.EntityPropertyGetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeIssueType,
+				EntityId:   issueTypeId,
+				Key:        key,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			value, err := structure.NormalizeJsonString(string(foundProperty.Value))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(issueTypeId)
+			if err = data.Set("issue_type_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("key", foundProperty.Key); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("value", value); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get issue type property")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Set(ctx, models2.EntityPropertySetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeIssueType,
+				EntityId:   strconv.Itoa(data.Get("issue_type_id").(int)),
+				Key:        data.Get("key").(string),
+				Value:      data.Get("value").(string),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update issue type property")
+			return IssueTypePropertyResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Delete(ctx, models2.EntityPropertyDeleteRequestModel{
+				EntityType: entitypropertyservice.EntityTypeIssueType,
+				EntityId:   strconv.Itoa(data.Get("issue_type_id").(int)),
+				Key:        data.Get("key").(string),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete issue type property")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"issue_type_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "id of issue type the property belongs to",
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of property",
+			},
+			"value": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "json value of property, compared semantically so formatting and key order cause no diff",
+			},
+		},
+	}
+}
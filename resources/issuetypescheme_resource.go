@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuetypeschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/issuetypeschemeservice/models"
+)
+
+func IssueTypeSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			defaultIssueTypeId := data.Get("default_issue_type_id").(int)
+			issueTypeIds := intListToStrings(data.Get("issue_type_ids").([]interface{}))
+			projectIds := intListToStrings(data.Get("project_ids").(*schema.Set).List())
+
+			issueTypeSchemeService := issuetypeschemeservice.IssueTypeSchemeService{
+				JiraServerBase: client,
+			}
+
+			createdScheme, err := issueTypeSchemeService.Create(ctx, models2.IssueTypeSchemeCreateRequestModel{
+				Name:               name,
+				Description:        description,
+				DefaultIssueTypeId: optionalIdToString(defaultIssueTypeId),
+				IssueTypeIds:       issueTypeIds,
+				ProjectIds:         projectIds,
+			})
+			if createdScheme.Id != "" {
+				data.SetId(createdScheme.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create issue type scheme")
+			return IssueTypeSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectIds := intListToStrings(data.Get("project_ids").(*schema.Set).List())
+
+			issueTypeSchemeService := issuetypeschemeservice.IssueTypeSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := issueTypeSchemeService.Get(ctx, models2.IssueTypeSchemeGetRequestModel{
+				Id:         data.Id(),
+				ProjectIds: projectIds,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundScheme.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			defaultIssueTypeId, _ := strconv.Atoi(foundScheme.DefaultIssueTypeId)
+			if err = data.Set("default_issue_type_id", defaultIssueTypeId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("issue_type_ids", stringsToIntList(foundScheme.IssueTypeIds)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_ids", stringsToIntList(foundScheme.ProjectIds)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundScheme.Id)
+			if err = data.Set("issue_type_scheme_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundScheme.Id)
+			log.Println("success get issue type scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			defaultIssueTypeId := data.Get("default_issue_type_id").(int)
+			issueTypeIds := intListToStrings(data.Get("issue_type_ids").([]interface{}))
+			oldProjectIds, newProjectIds := data.GetChange("project_ids")
+
+			issueTypeSchemeService := issuetypeschemeservice.IssueTypeSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueTypeSchemeService.Update(ctx, models2.IssueTypeSchemeUpdateRequestModel{
+				Id:                 data.Id(),
+				Name:               name,
+				Description:        description,
+				DefaultIssueTypeId: optionalIdToString(defaultIssueTypeId),
+				IssueTypeIds:       issueTypeIds,
+				ProjectIds:         intListToStrings(newProjectIds.(*schema.Set).List()),
+				OldProjectIds:      intListToStrings(oldProjectIds.(*schema.Set).List()),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update issue type scheme")
+			return IssueTypeSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			issueTypeSchemeService := issuetypeschemeservice.IssueTypeSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := issueTypeSchemeService.Delete(ctx, models2.IssueTypeSchemeDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete issue type scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of issue type scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of issue type scheme",
+			},
+			"default_issue_type_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of the default issue type, must be part of issue_type_ids",
+			},
+			"issue_type_ids": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "ordered ids of issue types in the issue type scheme",
+			},
+			"project_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "ids of projects using the issue type scheme",
+			},
+			"issue_type_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of issue type scheme",
+			},
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/notificationschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/notificationschemeservice/models"
+)
+
+func NotificationSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			notifications := expandNotifications(data.Get("notification").(*schema.Set).List())
+
+			notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+				JiraServerBase: client,
+			}
+
+			createdScheme, err := notificationSchemeService.Create(ctx, models2.NotificationSchemeCreateRequestModel{
+				Name:          name,
+				Description:   description,
+				Notifications: notifications,
+			})
+			if createdScheme.Id != "" {
+				data.SetId(createdScheme.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create notification scheme")
+			return NotificationSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := notificationSchemeService.Get(ctx, models2.NotificationSchemeGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundScheme.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("notification", FlattenNotificationSchemeNotifications(foundScheme.Notifications)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("notification_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundScheme.Id, 10))
+			log.Println("success get notification scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			notifications := expandNotifications(data.Get("notification").(*schema.Set).List())
+
+			notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := notificationSchemeService.Update(ctx, models2.NotificationSchemeUpdateRequestModel{
+				Id:            data.Id(),
+				Name:          name,
+				Description:   description,
+				Notifications: notifications,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update notification scheme")
+			return NotificationSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := notificationSchemeService.Delete(ctx, models2.NotificationSchemeDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete notification scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of notification scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of notification scheme",
+			},
+			"notification": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "event to recipient mappings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "id of event, e.g. 1 for issue created",
+						},
+						"recipient_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Group", "ProjectRole", "User", "Reporter", "CurrentAssignee", "AllWatchers",
+								"UserCustomField", "GroupCustomField", "CurrentUser", "ProjectLead", "ComponentLead", "EmailAddress",
+							}, false),
+							Description: "type of recipient, e.g. Group, ProjectRole, User, Reporter, CurrentAssignee, AllWatchers or UserCustomField",
+						},
+						"recipient_parameter": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "parameter of recipient, e.g. group name, project role id, user key or custom field id",
+						},
+					},
+				},
+			},
+			"notification_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of notification scheme",
+			},
+		},
+	}
+}
+
+// FlattenNotificationSchemeNotifications converts notifications into the notification block layout, they are already sorted by event and recipient
+func FlattenNotificationSchemeNotifications(notifications []models2.NotificationModel) []interface{} {
+	result := []interface{}{}
+	for _, notification := range notifications {
+		result = append(result, map[string]interface{}{
+			"event_id":            int(notification.EventId),
+			"recipient_type":      notification.Recipient.Type,
+			"recipient_parameter": notification.Recipient.Parameter,
+		})
+	}
+	return result
+}
+
+func expandNotifications(notifications []interface{}) []models2.NotificationModel {
+	result := []models2.NotificationModel{}
+	for _, raw := range notifications {
+		notification := raw.(map[string]interface{})
+		result = append(result, models2.NotificationModel{
+			EventId: int64(notification["event_id"].(int)),
+			Recipient: models2.NotificationRecipientModel{
+				Type:      notification["recipient_type"].(string),
+				Parameter: notification["recipient_parameter"].(string),
+			},
+		})
+	}
+	return result
+}
@@ -2,11 +2,14 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
 	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/grantservice"
+	models3 "terraform-provider-hashicups-pf/services/grantservice/models"
 	"terraform-provider-hashicups-pf/services/permissionschemeservice"
 	models2 "terraform-provider-hashicups-pf/services/permissionschemeservice/models"
 )
@@ -19,14 +22,19 @@ func PermissionSchemeResource() *schema.Resource {
 
 			name := data.Get("name").(string)
 			description := data.Get("description").(string)
-
 			permissionSchemeService := permissionschemeservice.PermissionSchemeService{
 				JiraServerBase: client,
 			}
 
+			permissions, err := copyPermissionSchemeGrants(ctx, client, data)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
 			createdPermSch, err := permissionSchemeService.Create(ctx, models2.PermissionSchemeCreateRequestModel{
 				Name:        name,
 				Description: description,
+				Permissions: permissions,
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -60,6 +68,7 @@ func PermissionSchemeResource() *schema.Resource {
 			}
 
 			updatedPermSch, err := permissionSchemeService.Update(ctx, models2.PermissionSchemeUpdateRequestModel{
+				Id:          int64(data.Get("permission_scheme_id").(int)),
 				Name:        name,
 				Description: description,
 			})
@@ -87,14 +96,17 @@ func PermissionSchemeResource() *schema.Resource {
 			var diags diag.Diagnostics
 			client := i.(models.JiraServerBase)
 
-			id := data.Get("permission_scheme_id").(int)
+			id, err := strconv.ParseInt(data.Id(), 10, 64)
+			if err != nil {
+				return diag.FromErr(errors.New("invalid permission scheme id " + data.Id()))
+			}
 
 			permissionSchemeService := permissionschemeservice.PermissionSchemeService{
 				JiraServerBase: client,
 			}
 
 			foundPermSch, err := permissionSchemeService.Get(ctx, models2.PermissionSchemeGetRequestModel{
-				Id: int64(id),
+				Id: id,
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -108,12 +120,16 @@ func PermissionSchemeResource() *schema.Resource {
 				return diag.FromErr(err)
 			}
 
+			if err = data.Set("grant", FlattenPermissionSchemeGrants(foundPermSch.Permissions)); err != nil {
+				return diag.FromErr(err)
+			}
+
 			if err = data.Set("permission_scheme_id", int(foundPermSch.Id)); err != nil {
 				return diag.FromErr(err)
 			}
 
 			data.SetId(strconv.FormatInt(foundPermSch.Id, 10))
-			log.Println("success update permission scheme")
+			log.Println("success get permission scheme")
 			return diags
 		},
 		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -135,6 +151,9 @@ func PermissionSchemeResource() *schema.Resource {
 			log.Println("success delete permission scheme")
 			return diags
 		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
@@ -146,6 +165,26 @@ func PermissionSchemeResource() *schema.Resource {
 				Required:    true,
 				Description: "description of permission scheme",
 			},
+			"copy_from_scheme_id": &schema.Schema{
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ConflictsWith:    []string{"copy_from_scheme_name"},
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "id of permission scheme whose grants are copied when the scheme is created, later changes are ignored",
+			},
+			"copy_from_scheme_name": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"copy_from_scheme_id"},
+				DiffSuppressFunc: suppressAfterCreate,
+				Description:      "name of permission scheme whose grants are copied when the scheme is created, e.g. Default Permission Scheme. later changes are ignored",
+			},
+			"grant": &schema.Schema{
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "grants of permission scheme with their holder details",
+				Elem:        PermissionSchemeGrantSchema(),
+			},
 			"permission_scheme_id": &schema.Schema{
 				Type:        schema.TypeInt,
 				Computed:    true,
@@ -154,3 +193,117 @@ func PermissionSchemeResource() *schema.Resource {
 		},
 	}
 }
+
+// copyPermissionSchemeGrants reads the grants of the source scheme, the copy is only made on create
+// so grants added or removed afterwards are managed through jiraserverfatih_grant
+func copyPermissionSchemeGrants(ctx context.Context, client models.JiraServerBase, data *schema.ResourceData) ([]models2.PermissionSchemeGrantModel, error) {
+	// the default permission scheme has id 0, so only the raw config tells whether an id was given
+	idSet := !data.GetRawConfig().GetAttr("copy_from_scheme_id").IsNull()
+	sourceId := int64(data.Get("copy_from_scheme_id").(int))
+	sourceName := data.Get("copy_from_scheme_name").(string)
+	if !idSet && sourceName == "" {
+		return nil, nil
+	}
+
+	if sourceName != "" {
+		permissionSchemeService := permissionschemeservice.PermissionSchemeService{
+			JiraServerBase: client,
+		}
+
+		permissionSchemes, err := permissionSchemeService.List(ctx, models2.PermissionSchemeListRequestModel{})
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, permissionScheme := range permissionSchemes.PermissionSchemes {
+			if permissionScheme.Name == sourceName {
+				sourceId, found = permissionScheme.Id, true
+			}
+		}
+		if !found {
+			return nil, errors.New("failed to find permission scheme " + sourceName + " to copy from")
+		}
+	}
+
+	grantService := grantservice.GrantService{
+		JiraServerBase: client,
+	}
+
+	grants, err := grantService.List(ctx, models3.GrantListRequestModel{
+		PermissionSchemeId: sourceId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := []models2.PermissionSchemeGrantModel{}
+	for _, grant := range grants.Grants {
+		permissions = append(permissions, models2.PermissionSchemeGrantModel{
+			Permission: grant.Permission,
+			Holder: models2.PermissionSchemeHolderModel{
+				Type:      grant.Holder.Type,
+				Parameter: grant.Holder.Parameter,
+			},
+		})
+	}
+	return permissions, nil
+}
+
+// PermissionSchemeGrantSchema describes a grant of an expanded permission scheme, shared with the data source
+func PermissionSchemeGrantSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"grant_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of grant",
+			},
+			"permission": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "granted permission, e.g. ADD_COMMENTS",
+			},
+			"holder_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "type of holder, e.g. projectRole, group or user",
+			},
+			"holder_parameter": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "parameter of holder, e.g. project role id or group name",
+			},
+			"holder_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "display name of the holder user, group, project role or field",
+			},
+		},
+	}
+}
+
+// FlattenPermissionSchemeGrants converts expanded grants into the PermissionSchemeGrantSchema layout
+func FlattenPermissionSchemeGrants(grants []models2.PermissionSchemeGrantResponseModel) []interface{} {
+	result := []interface{}{}
+	for _, grant := range grants {
+		holderName := ""
+		switch {
+		case grant.Holder.User != nil:
+			holderName = grant.Holder.User.DisplayName
+		case grant.Holder.Group != nil:
+			holderName = grant.Holder.Group.Name
+		case grant.Holder.ProjectRole != nil:
+			holderName = grant.Holder.ProjectRole.Name
+		case grant.Holder.Field != nil:
+			holderName = grant.Holder.Field.Name
+		}
+		result = append(result, map[string]interface{}{
+			"grant_id":         int(grant.Id),
+			"permission":       grant.Permission,
+			"holder_type":      grant.Holder.Type,
+			"holder_parameter": grant.Holder.Parameter,
+			"holder_name":      holderName,
+		})
+	}
+	return result
+}
@@ -0,0 +1,227 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"regexp"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/priorityservice"
+	models2 "terraform-provider-hashicups-pf/services/priorityservice/models"
+)
+
+func PriorityResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			statusColor := data.Get("status_color").(string)
+			iconUrl := data.Get("icon_url").(string)
+			afterPriorityId := data.Get("after_priority_id").(int)
+			position := data.Get("position").(string)
+			if position != "" {
+				afterPriorityId = 0
+			}
+
+			priorityService := priorityservice.PriorityService{
+				JiraServerBase: client,
+			}
+
+			createdPriority, err := priorityService.Create(ctx, models2.PriorityCreateRequestModel{
+				Name:            name,
+				Description:     description,
+				StatusColor:     statusColor,
+				IconUrl:         iconUrl,
+				AfterPriorityId: optionalIdToString(afterPriorityId),
+				Position:        position,
+			})
+			if createdPriority.Id != "" {
+				data.SetId(createdPriority.Id)
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success create priority")
+			return PriorityResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			priorityService := priorityservice.PriorityService{
+				JiraServerBase: client,
+			}
+
+			foundPriority, err := priorityService.Get(ctx, models2.PriorityGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundPriority.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundPriority.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("status_color", foundPriority.StatusColor); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("icon_url", foundPriority.IconUrl); err != nil {
+				return diag.FromErr(err)
+			}
+
+			afterPriorityId, _ := strconv.Atoi(foundPriority.AfterPriorityId)
+			if err = data.Set("after_priority_id", afterPriorityId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			// jira only reports the ordering, a position that no longer holds shows up as drift
+			position := data.Get("position").(string)
+			if (position == "First" && foundPriority.Sequence != 1) || (position == "Last" && !foundPriority.Last) {
+				position = ""
+			}
+			if err = data.Set("position", position); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("sequence", int(foundPriority.Sequence)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundPriority.Id)
+			if err = data.Set("priority_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundPriority.Id)
+			log.Println("success get priority")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			statusColor := data.Get("status_color").(string)
+			iconUrl := data.Get("icon_url").(string)
+
+			// only move the priority when the ordering was changed, moving is not idempotent in jira
+			afterPriorityId := ""
+			position := ""
+			if data.HasChanges("after_priority_id", "position") {
+				position = data.Get("position").(string)
+				if position == "" {
+					afterPriorityId = optionalIdToString(data.Get("after_priority_id").(int))
+				}
+			}
+
+			priorityService := priorityservice.PriorityService{
+				JiraServerBase: client,
+			}
+
+			_, err := priorityService.Update(ctx, models2.PriorityUpdateRequestModel{
+				Id:              data.Id(),
+				Name:            name,
+				Description:     description,
+				StatusColor:     statusColor,
+				IconUrl:         iconUrl,
+				AfterPriorityId: afterPriorityId,
+				Position:        position,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update priority")
+			return PriorityResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			replaceWith := data.Get("replace_with_priority_id").(int)
+
+			priorityService := priorityservice.PriorityService{
+				JiraServerBase: client,
+			}
+
+			_, err := priorityService.Delete(ctx, models2.PriorityDeleteRequestModel{
+				Id:          data.Id(),
+				ReplaceWith: optionalIdToString(replaceWith),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete priority")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of priority",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of priority",
+			},
+			"status_color": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^#[0-9a-fA-F]{3}([0-9a-fA-F]{3})?$`), "must be a hex color, e.g. #ff0000"),
+				Description:  "color of priority as hex, e.g. #ff0000",
+			},
+			"icon_url": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "url of priority icon, defaults to jira's icon",
+			},
+			"after_priority_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "id of priority this priority is ordered after, omit to keep jira's ordering",
+			},
+			"position": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{"First", "Last"}, false),
+				ConflictsWith: []string{"after_priority_id"},
+				Description:   "moves the priority to the start or end of jira's ordering, valid values: First or Last",
+			},
+			"replace_with_priority_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of priority that issues are moved to when this priority is destroyed",
+			},
+			"sequence": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "position of priority in jira's ordering, starting at 1",
+			},
+			"priority_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of priority",
+			},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectcategoryservice"
+	models2 "terraform-provider-hashicups-pf/services/projectcategoryservice/models"
+)
+
+func ProjectCategoryResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+
+			projectCategoryService := projectcategoryservice.ProjectCategoryService{
+				JiraServerBase: client,
+			}
+
+			createdCategory, err := projectCategoryService.Create(ctx, models2.ProjectCategoryCreateRequestModel{
+				Name:        name,
+				Description: description,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdCategory.Id)
+			log.Println("success create project category")
+			return ProjectCategoryResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectCategoryService := projectcategoryservice.ProjectCategoryService{
+				JiraServerBase: client,
+			}
+
+			foundCategory, err := projectCategoryService.Get(ctx, models2.ProjectCategoryGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundCategory.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundCategory.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundCategory.Id)
+			if err = data.Set("project_category_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundCategory.Id)
+			log.Println("success get project category")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+
+			projectCategoryService := projectcategoryservice.ProjectCategoryService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectCategoryService.Update(ctx, models2.ProjectCategoryUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project category")
+			return ProjectCategoryResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectCategoryService := projectcategoryservice.ProjectCategoryService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectCategoryService.Delete(ctx, models2.ProjectCategoryDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project category")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of project category",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of project category",
+			},
+			"project_category_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of project category",
+			},
+		},
+	}
+}
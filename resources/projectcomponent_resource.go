@@ -0,0 +1,181 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectcomponentservice"
+	models2 "terraform-provider-hashicups-pf/services/projectcomponentservice/models"
+)
+
+func ProjectComponentResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			projectKey := data.Get("project_key").(string)
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			leadUserName := data.Get("lead_user_name").(string)
+			assigneeType := data.Get("assignee_type").(string)
+
+			projectComponentService := projectcomponentservice.ProjectComponentService{
+				JiraServerBase: client,
+			}
+
+			createdComponent, err := projectComponentService.Create(ctx, models2.ProjectComponentCreateRequestModel{
+				Name:         name,
+				Description:  description,
+				LeadUserName: leadUserName,
+				AssigneeType: assigneeType,
+				Project:      projectKey,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdComponent.Id)
+			log.Println("success create project component")
+			return ProjectComponentResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectComponentService := projectcomponentservice.ProjectComponentService{
+				JiraServerBase: client,
+			}
+
+			foundComponent, err := projectComponentService.Get(ctx, models2.ProjectComponentGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", foundComponent.Project); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundComponent.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundComponent.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("lead_user_name", foundComponent.LeadUserName); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("assignee_type", foundComponent.AssigneeType); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundComponent.Id)
+			if err = data.Set("component_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundComponent.Id)
+			log.Println("success get project component")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			leadUserName := data.Get("lead_user_name").(string)
+			assigneeType := data.Get("assignee_type").(string)
+
+			projectComponentService := projectcomponentservice.ProjectComponentService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectComponentService.Update(ctx, models2.ProjectComponentUpdateRequestModel{
+				Id:           data.Id(),
+				Name:         name,
+				Description:  description,
+				LeadUserName: leadUserName,
+				AssigneeType: assigneeType,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project component")
+			return ProjectComponentResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			moveIssuesTo := data.Get("move_issues_to_component_id").(int)
+
+			projectComponentService := projectcomponentservice.ProjectComponentService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectComponentService.Delete(ctx, models2.ProjectComponentDeleteRequestModel{
+				Id:           data.Id(),
+				MoveIssuesTo: optionalIdToString(moveIssuesTo),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project component")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of project the component belongs to",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of component",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of component",
+			},
+			"lead_user_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "username of component lead",
+			},
+			"assignee_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "PROJECT_DEFAULT",
+				ValidateFunc: validation.StringInSlice([]string{"PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"}, false),
+				Description:  "default assignee type, valid values: PROJECT_DEFAULT, COMPONENT_LEAD, PROJECT_LEAD or UNASSIGNED",
+			},
+			"move_issues_to_component_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of component that issues are moved to when this component is destroyed",
+			},
+			"component_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of component",
+			},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/projectschemeservice/models"
+)
+
+func ProjectNotificationSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			projectKey := data.Get("project_key").(string)
+			schemeId := data.Get("notification_scheme_id").(int)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			// remember the scheme attached before so destroy can put it back
+			previousScheme, err := projectSchemeService.Get(ctx, models2.ProjectSchemeGetRequestModel{
+				ProjectKey: projectKey,
+				SchemeType: projectschemeservice.SchemeTypeNotification,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			// projects may have no notification scheme, there is nothing to restore then
+			previousSchemeId := ""
+			if previousScheme.Id != 0 {
+				previousSchemeId = strconv.FormatInt(previousScheme.Id, 10)
+			}
+			if err = data.Set("previous_notification_scheme_id", previousSchemeId); err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+				ProjectKey: projectKey,
+				SchemeType: projectschemeservice.SchemeTypeNotification,
+				SchemeId:   int64(schemeId),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(projectKey)
+			log.Println("success create project notification scheme")
+			return ProjectNotificationSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := projectSchemeService.Get(ctx, models2.ProjectSchemeGetRequestModel{
+				ProjectKey: data.Id(),
+				SchemeType: projectschemeservice.SchemeTypeNotification,
+			})
+			if errors.Is(err, projectschemeservice.ErrProjectNotFound) {
+				// project removed outside terraform, the attachment went with it
+				log.Println("project not found, removing project notification scheme from state")
+				data.SetId("")
+				return diags
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", data.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("notification_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get project notification scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			schemeId := data.Get("notification_scheme_id").(int)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+				ProjectKey: data.Id(),
+				SchemeType: projectschemeservice.SchemeTypeNotification,
+				SchemeId:   int64(schemeId),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project notification scheme")
+			return ProjectNotificationSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			var err error
+			previousSchemeId := data.Get("previous_notification_scheme_id").(string)
+			if previousSchemeId == "" {
+				// nothing recorded to put back, either the project had no scheme or the attachment was imported
+				_, err = projectSchemeService.SetDefault(ctx, models2.ProjectSchemeSetDefaultRequestModel{
+					ProjectKey: data.Id(),
+					SchemeType: projectschemeservice.SchemeTypeNotification,
+				})
+			} else {
+				schemeId, parseErr := strconv.ParseInt(previousSchemeId, 10, 64)
+				if parseErr != nil {
+					return diag.FromErr(errors.New("invalid previous notification scheme id " + previousSchemeId))
+				}
+
+				_, err = projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+					ProjectKey: data.Id(),
+					SchemeType: projectschemeservice.SchemeTypeNotification,
+					SchemeId:   schemeId,
+				})
+			}
+			if err != nil && !errors.Is(err, projectschemeservice.ErrProjectNotFound) {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project notification scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of project the scheme is attached to",
+			},
+			"notification_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "id of notification scheme attached to the project",
+			},
+			"previous_notification_scheme_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "id of notification scheme attached before, restored on destroy; empty when the project had none or the attachment was imported, destroy then attaches the default notification scheme",
+			},
+		},
+	}
+}
@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/projectschemeservice/models"
+)
+
+func ProjectPermissionSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			projectKey := data.Get("project_key").(string)
+			schemeId := data.Get("permission_scheme_id").(int)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			// remember the scheme attached before so destroy can put it back
+			previousScheme, err := projectSchemeService.Get(ctx, models2.ProjectSchemeGetRequestModel{
+				ProjectKey: projectKey,
+				SchemeType: projectschemeservice.SchemeTypePermission,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("previous_permission_scheme_id", strconv.FormatInt(previousScheme.Id, 10)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+				ProjectKey: projectKey,
+				SchemeType: projectschemeservice.SchemeTypePermission,
+				SchemeId:   int64(schemeId),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(projectKey)
+			log.Println("success create project permission scheme")
+			return ProjectPermissionSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := projectSchemeService.Get(ctx, models2.ProjectSchemeGetRequestModel{
+				ProjectKey: data.Id(),
+				SchemeType: projectschemeservice.SchemeTypePermission,
+			})
+			if errors.Is(err, projectschemeservice.ErrProjectNotFound) {
+				// project removed outside terraform, the attachment went with it
+				log.Println("project not found, removing project permission scheme from state")
+				data.SetId("")
+				return diags
+			}
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", data.Id()); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("permission_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get project permission scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			schemeId := data.Get("permission_scheme_id").(int)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+				ProjectKey: data.Id(),
+				SchemeType: projectschemeservice.SchemeTypePermission,
+				SchemeId:   int64(schemeId),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project permission scheme")
+			return ProjectPermissionSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectSchemeService := projectschemeservice.ProjectSchemeService{
+				JiraServerBase: client,
+			}
+
+			var err error
+			previousSchemeId := data.Get("previous_permission_scheme_id").(string)
+			if previousSchemeId == "" {
+				// imported attachments never saw the scheme they replaced
+				_, err = projectSchemeService.SetDefault(ctx, models2.ProjectSchemeSetDefaultRequestModel{
+					ProjectKey: data.Id(),
+					SchemeType: projectschemeservice.SchemeTypePermission,
+				})
+			} else {
+				schemeId, parseErr := strconv.ParseInt(previousSchemeId, 10, 64)
+				if parseErr != nil {
+					return diag.FromErr(errors.New("invalid previous permission scheme id " + previousSchemeId))
+				}
+
+				_, err = projectSchemeService.Set(ctx, models2.ProjectSchemeSetRequestModel{
+					ProjectKey: data.Id(),
+					SchemeType: projectschemeservice.SchemeTypePermission,
+					SchemeId:   schemeId,
+				})
+			}
+			if err != nil && !errors.Is(err, projectschemeservice.ErrProjectNotFound) {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project permission scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of project the scheme is attached to",
+			},
+			"permission_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "id of permission scheme attached to the project",
+			},
+			"previous_permission_scheme_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "id of permission scheme attached before, restored on destroy; empty for imported attachments, which fall back to the default permission scheme",
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/entitypropertyservice"
+	models2 "terraform-provider-hashicups-pf/services/entitypropertyservice/models"
+)
+
+func ProjectPropertyResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			projectKey := data.Get("project_key").(string)
+			key := data.Get("key").(string)
+			value := data.Get("value").(string)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Set(ctx, models2.EntityPropertySetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeProject,
+				EntityId:   projectKey,
+				Key:        key,
+				Value:      value,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(projectKey + "/" + key)
+			log.Println("success create project property")
+			return ProjectPropertyResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectKey, key, found := strings.Cut(data.Id(), "/")
+			if !found {
+				return diag.FromErr(errors.New("invalid project property id " + data.Id() + ", expected <project_key>/<property_key>"))
+			}
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			foundProperty, err := entityPropertyService.Get(ctx, models2.EntityPropertyGetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeProject,
+				EntityId:   projectKey,
+				Key:        key,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			value, err := structure.NormalizeJsonString(string(foundProperty.Value))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", projectKey); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("key", foundProperty.Key); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("value", value); err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success get project property")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Set(ctx, models2.EntityPropertySetRequestModel{
+				EntityType: entitypropertyservice.EntityTypeProject,
+				EntityId:   data.Get("project_key").(string),
+				Key:        data.Get("key").(string),
+				Value:      data.Get("value").(string),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project property")
+			return ProjectPropertyResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			entityPropertyService := entitypropertyservice.EntityPropertyService{
+				JiraServerBase: client,
+			}
+
+			_, err := entityPropertyService.Delete(ctx, models2.EntityPropertyDeleteRequestModel{
+				EntityType: entitypropertyservice.EntityTypeProject,
+				EntityId:   data.Get("project_key").(string),
+				Key:        data.Get("key").(string),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project property")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of project the property belongs to",
+			},
+			"key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of property",
+			},
+			"value": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+				Description:      "json value of property, compared semantically so formatting and key order cause no diff",
+			},
+		},
+	}
+}
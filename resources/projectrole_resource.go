@@ -2,10 +2,12 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
+	"strings"
 	"terraform-provider-hashicups-pf/services/baseservice/models"
 	"terraform-provider-hashicups-pf/services/projectroleservice"
 	models2 "terraform-provider-hashicups-pf/services/projectroleservice/models"
@@ -52,15 +54,24 @@ func ProjectRoleResource() *schema.Resource {
 			var diags diag.Diagnostics
 			client := i.(models.JiraServerBase)
 
-			id := data.Get("project_role_id").(int)
+			id, err := strconv.ParseInt(data.Id(), 10, 64)
+			if err != nil {
+				return diag.FromErr(errors.New("invalid project role id " + data.Id()))
+			}
 
 			projectRoleService := projectroleservice.ProjectRoleService{
 				JiraServerBase: client,
 			}
 
 			projectRole, err := projectRoleService.GetRole(ctx, models2.ProjectRoleGetRequestModel{
-				Id: int64(id),
+				Id: id,
 			})
+			if errors.Is(err, projectroleservice.ErrRoleNotFound) {
+				// removed outside terraform, let the plan recreate it
+				log.Println("project role not found, removing from state")
+				data.SetId("")
+				return diags
+			}
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -129,7 +140,8 @@ func ProjectRoleResource() *schema.Resource {
 			}
 
 			_, err := projectRoleService.DeleteRole(ctx, models2.ProjectRoleDeleteRequestModel{
-				Id: int64(id),
+				Id:   int64(id),
+				Swap: int64(data.Get("swap_role_id").(int)),
 			})
 			if err != nil {
 				return diag.FromErr(err)
@@ -139,6 +151,32 @@ func ProjectRoleResource() *schema.Resource {
 			log.Println("success delete project role")
 			return diags
 		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if !diff.HasChange("name") || !diff.NewValueKnown("name") {
+				return nil
+			}
+
+			projectRoleService := projectroleservice.ProjectRoleService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+
+			roles, err := projectRoleService.ListRoles(ctx, models2.ProjectRoleListRequestModel{})
+			if err != nil {
+				return err
+			}
+
+			// jira compares role names case insensitively
+			name := diff.Get("name").(string)
+			for _, role := range roles {
+				if strings.EqualFold(role.Name, name) && strconv.FormatInt(role.Id, 10) != diff.Id() {
+					return errors.New("project role " + role.Name + " already exists with id " + strconv.FormatInt(role.Id, 10))
+				}
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:        schema.TypeString,
@@ -150,6 +188,11 @@ func ProjectRoleResource() *schema.Resource {
 				Required:    true,
 				Description: "description of project role",
 			},
+			"swap_role_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of project role that takes over the scheme usages of this role when it is destroyed",
+			},
 			"project_role_id": &schema.Schema{
 				Type:        schema.TypeInt,
 				Computed:    true,
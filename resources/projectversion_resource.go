@@ -0,0 +1,219 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"regexp"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectversionservice"
+	models2 "terraform-provider-hashicups-pf/services/projectversionservice/models"
+)
+
+func ProjectVersionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			projectKey := data.Get("project_key").(string)
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			startDate := data.Get("start_date").(string)
+			releaseDate := data.Get("release_date").(string)
+			released := data.Get("released").(bool)
+			archived := data.Get("archived").(bool)
+
+			projectVersionService := projectversionservice.ProjectVersionService{
+				JiraServerBase: client,
+			}
+
+			createdVersion, err := projectVersionService.Create(ctx, models2.ProjectVersionCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Project:     projectKey,
+				StartDate:   startDate,
+				ReleaseDate: releaseDate,
+				Released:    released,
+				Archived:    archived,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdVersion.Id)
+			log.Println("success create project version")
+			return ProjectVersionResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			projectVersionService := projectversionservice.ProjectVersionService{
+				JiraServerBase: client,
+			}
+
+			foundVersion, err := projectVersionService.Get(ctx, models2.ProjectVersionGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundVersion.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundVersion.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("start_date", foundVersion.StartDate); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("release_date", foundVersion.ReleaseDate); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("released", foundVersion.Released); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("archived", foundVersion.Archived); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_id", int(foundVersion.ProjectId)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("project_key", foundVersion.ProjectKey); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundVersion.Id)
+			if err = data.Set("version_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundVersion.Id)
+			log.Println("success get project version")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			startDate := data.Get("start_date").(string)
+			releaseDate := data.Get("release_date").(string)
+			released := data.Get("released").(bool)
+			archived := data.Get("archived").(bool)
+
+			projectVersionService := projectversionservice.ProjectVersionService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectVersionService.Update(ctx, models2.ProjectVersionUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+				StartDate:   optionalString(startDate),
+				ReleaseDate: optionalString(releaseDate),
+				Released:    released,
+				Archived:    archived,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update project version")
+			return ProjectVersionResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			moveIssuesTo := data.Get("move_issues_to_version_id").(int)
+
+			projectVersionService := projectversionservice.ProjectVersionService{
+				JiraServerBase: client,
+			}
+
+			_, err := projectVersionService.Delete(ctx, models2.ProjectVersionDeleteRequestModel{
+				Id:           data.Id(),
+				MoveIssuesTo: int64(moveIssuesTo),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete project version")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "key of project the version belongs to",
+			},
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of version",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of version",
+			},
+			"start_date": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be formatted as yyyy-mm-dd"),
+				Description:  "start date of version, formatted as yyyy-mm-dd",
+			},
+			"release_date": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be formatted as yyyy-mm-dd"),
+				Description:  "release date of version, formatted as yyyy-mm-dd",
+			},
+			"released": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether the version is released",
+			},
+			"archived": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether the version is archived",
+			},
+			"move_issues_to_version_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of version that fix and affected issues are moved to when this version is destroyed",
+			},
+			"project_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of project the version belongs to",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of version",
+			},
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/resolutionservice"
+	models2 "terraform-provider-hashicups-pf/services/resolutionservice/models"
+)
+
+func ResolutionResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+
+			resolutionService := resolutionservice.ResolutionService{
+				JiraServerBase: client,
+			}
+
+			createdResolution, err := resolutionService.Create(ctx, models2.ResolutionCreateRequestModel{
+				Name:        name,
+				Description: description,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdResolution.Id)
+			log.Println("success create resolution")
+			return ResolutionResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			resolutionService := resolutionservice.ResolutionService{
+				JiraServerBase: client,
+			}
+
+			foundResolution, err := resolutionService.Get(ctx, models2.ResolutionGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundResolution.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundResolution.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundResolution.Id)
+			if err = data.Set("resolution_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundResolution.Id)
+			log.Println("success get resolution")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+
+			resolutionService := resolutionservice.ResolutionService{
+				JiraServerBase: client,
+			}
+
+			_, err := resolutionService.Update(ctx, models2.ResolutionUpdateRequestModel{
+				Id:          data.Id(),
+				Name:        name,
+				Description: description,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update resolution")
+			return ResolutionResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			replaceWith := data.Get("replace_with_resolution_id").(int)
+
+			resolutionService := resolutionservice.ResolutionService{
+				JiraServerBase: client,
+			}
+
+			_, err := resolutionService.Delete(ctx, models2.ResolutionDeleteRequestModel{
+				Id:          data.Id(),
+				ReplaceWith: optionalIdToString(replaceWith),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete resolution")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of resolution",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of resolution",
+			},
+			"replace_with_resolution_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "id of resolution that issues are moved to when this resolution is destroyed",
+			},
+			"resolution_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of resolution",
+			},
+		},
+	}
+}
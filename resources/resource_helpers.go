@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strconv"
+)
+
+func intListToStrings(values []interface{}) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, strconv.Itoa(value.(int)))
+	}
+	return result
+}
+
+func stringsToIntList(values []string) []int {
+	result := []int{}
+	for _, value := range values {
+		param, _ := strconv.Atoi(value)
+		result = append(result, param)
+	}
+	return result
+}
+
+func optionalIdToString(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
+
+// optionalString turns an unset string into nil, so it is sent as null and clears the value in jira
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+// suppressAfterCreate hides changes to attributes that only matter when the resource is created
+func suppressAfterCreate(k, oldValue, newValue string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func interfaceListToStrings(values []interface{}) []string {
+	result := []string{}
+	for _, value := range values {
+		result = append(result, value.(string))
+	}
+	return result
+}
@@ -0,0 +1,157 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/statusservice"
+	models2 "terraform-provider-hashicups-pf/services/statusservice/models"
+)
+
+func StatusResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			category := data.Get("category").(string)
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: client,
+			}
+
+			createdStatus, err := statusService.Create(ctx, models2.StatusCreateRequestModel{
+				Name:           name,
+				Description:    description,
+				StatusCategory: category,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdStatus.Id)
+			log.Println("success create status")
+			return StatusResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: client,
+			}
+
+			foundStatus, err := statusService.Get(ctx, models2.StatusGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundStatus.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundStatus.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("category", statusservice.Categories[foundStatus.StatusCategory.Key]); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundStatus.Id)
+			if err = data.Set("status_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundStatus.Id)
+			log.Println("success get status")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			category := data.Get("category").(string)
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: client,
+			}
+
+			_, err := statusService.Update(ctx, models2.StatusUpdateRequestModel{
+				Id:             data.Id(),
+				Name:           name,
+				Description:    description,
+				StatusCategory: category,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update status")
+			return StatusResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: client,
+			}
+
+			_, err := statusService.Delete(ctx, models2.StatusDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete status")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			if diff.Id() != "" && !diff.HasChanges("name", "description", "category") {
+				return nil
+			}
+
+			statusService := statusservice.StatusService{
+				JiraServerBase: i.(models.JiraServerBase),
+			}
+			return statusService.CheckSupport(ctx)
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of status",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of status",
+			},
+			"category": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"TODO", "IN_PROGRESS", "DONE"}, false),
+				Description:  "status category, valid values: TODO, IN_PROGRESS or DONE",
+			},
+			"status_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of status",
+			},
+		},
+	}
+}
@@ -0,0 +1,193 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/webhookservice"
+	models2 "terraform-provider-hashicups-pf/services/webhookservice/models"
+)
+
+func WebhookResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			webhookService := webhookservice.WebhookService{
+				JiraServerBase: client,
+			}
+
+			createdWebhook, err := webhookService.Create(ctx, models2.WebhookCreateRequestModel{
+				Name:   data.Get("name").(string),
+				Url:    data.Get("url").(string),
+				Events: interfaceListToStrings(data.Get("events").(*schema.Set).List()),
+				Filters: models2.WebhookFiltersModel{
+					IssueRelatedEventsSection: data.Get("jql_filter").(string),
+				},
+				ExcludeBody: data.Get("exclude_body").(bool),
+				Enabled:     data.Get("enabled").(bool),
+				Secret:      data.Get("secret").(string),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdWebhook.Id)
+			log.Println("success create webhook")
+			return WebhookResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			webhookService := webhookservice.WebhookService{
+				JiraServerBase: client,
+			}
+
+			foundWebhook, err := webhookService.Get(ctx, models2.WebhookGetRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundWebhook.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("url", foundWebhook.Url); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("events", foundWebhook.Events); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("jql_filter", foundWebhook.Filters.IssueRelatedEventsSection); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("exclude_body", foundWebhook.ExcludeBody); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("enabled", foundWebhook.Enabled); err != nil {
+				return diag.FromErr(err)
+			}
+
+			param, _ := strconv.Atoi(foundWebhook.Id)
+			if err = data.Set("webhook_id", param); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundWebhook.Id)
+			log.Println("success get webhook")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			webhookService := webhookservice.WebhookService{
+				JiraServerBase: client,
+			}
+
+			secret := optionalString(data.Get("secret").(string))
+			if secret == nil && data.HasChange("secret") {
+				// an explicit empty secret clears it, leaving it out keeps the current one
+				secret = new(string)
+			}
+
+			_, err := webhookService.Update(ctx, models2.WebhookUpdateRequestModel{
+				Id:     data.Id(),
+				Name:   data.Get("name").(string),
+				Url:    data.Get("url").(string),
+				Events: interfaceListToStrings(data.Get("events").(*schema.Set).List()),
+				Filters: models2.WebhookFiltersModel{
+					IssueRelatedEventsSection: data.Get("jql_filter").(string),
+				},
+				ExcludeBody: data.Get("exclude_body").(bool),
+				Enabled:     data.Get("enabled").(bool),
+				Secret:      secret,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update webhook")
+			return WebhookResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			webhookService := webhookservice.WebhookService{
+				JiraServerBase: client,
+			}
+
+			_, err := webhookService.Delete(ctx, models2.WebhookDeleteRequestModel{
+				Id: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete webhook")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of webhook",
+			},
+			"url": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "url jira posts the events to",
+			},
+			"events": &schema.Schema{
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "events triggering the webhook, e.g. jira:issue_created or project_updated",
+			},
+			"jql_filter": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "jql restricting the issue related events",
+			},
+			"exclude_body": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "whether jira posts the event without a json body",
+			},
+			"enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "whether the webhook is enabled",
+			},
+			"secret": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "secret used to sign the payload, supported by newer jira data center versions. jira never returns it so it is not drift checked",
+			},
+			"webhook_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of webhook",
+			},
+		},
+	}
+}
@@ -0,0 +1,191 @@
+package resources
+
+import (
+	"context"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"os"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/workflowservice"
+	models2 "terraform-provider-hashicups-pf/services/workflowservice/models"
+)
+
+func WorkflowResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			filePath := data.Get("file_path").(string)
+
+			definition, err := os.ReadFile(filePath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			workflowService := workflowservice.WorkflowService{
+				JiraServerBase: client,
+			}
+
+			createdWorkflow, err := workflowService.Create(ctx, models2.WorkflowCreateRequestModel{
+				Name:        name,
+				Description: description,
+				Definition:  string(definition),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(createdWorkflow.Name)
+			log.Println("success create workflow")
+			return WorkflowResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			workflowService := workflowservice.WorkflowService{
+				JiraServerBase: client,
+			}
+
+			foundWorkflow, err := workflowService.Get(ctx, models2.WorkflowGetRequestModel{
+				Name: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			hash, err := workflowservice.DefinitionHash(foundWorkflow.Definition)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundWorkflow.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundWorkflow.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("definition_sha256", hash); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(foundWorkflow.Name)
+			log.Println("success get workflow")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			description := data.Get("description").(string)
+			filePath := data.Get("file_path").(string)
+			publishDraft := data.Get("publish_draft").(bool)
+
+			definition, err := os.ReadFile(filePath)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			workflowService := workflowservice.WorkflowService{
+				JiraServerBase: client,
+			}
+
+			_, err = workflowService.Update(ctx, models2.WorkflowUpdateRequestModel{
+				Name:         data.Id(),
+				Description:  description,
+				Definition:   string(definition),
+				PublishDraft: publishDraft,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update workflow")
+			return WorkflowResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			workflowService := workflowservice.WorkflowService{
+				JiraServerBase: client,
+			}
+
+			_, err := workflowService.Delete(ctx, models2.WorkflowDeleteRequestModel{
+				Name: data.Id(),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			_, err = workflowService.Get(ctx, models2.WorkflowGetRequestModel{
+				Name: data.Id(),
+			})
+			if err == nil {
+				return diag.FromErr(errors.New("workflow " + data.Id() + " is active, remove it from all workflow schemes before destroying it"))
+			}
+
+			data.SetId("")
+			log.Println("success delete workflow")
+			return diags
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+			filePath := diff.Get("file_path").(string)
+			if diff.Id() == "" || filePath == "" {
+				return nil
+			}
+
+			definition, err := os.ReadFile(filePath)
+			if err != nil {
+				return err
+			}
+
+			hash, err := workflowservice.DefinitionHash(string(definition))
+			if err != nil {
+				return err
+			}
+
+			if diff.Get("definition_sha256").(string) != hash {
+				return diff.SetNew("definition_sha256", hash)
+			}
+			return nil
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "name of workflow",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of workflow",
+			},
+			"file_path": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "path to the workflow xml definition as exported from jira, changes to active workflows need publish_draft",
+			},
+			"publish_draft": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "when the workflow is active, load changes into a draft of it and publish the draft instead of failing",
+			},
+			"definition_sha256": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "sha256 of the normalized workflow xml, used for drift detection",
+			},
+		},
+	}
+}
@@ -0,0 +1,243 @@
+package resources
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"log"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/workflowschemeservice"
+	models2 "terraform-provider-hashicups-pf/services/workflowschemeservice/models"
+)
+
+func WorkflowSchemeResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			defaultWorkflow := data.Get("default_workflow").(string)
+			issueTypeMappings := expandWorkflowSchemeMappings(data.Get("issue_type_mapping").(*schema.Set).List())
+
+			workflowSchemeService := workflowschemeservice.WorkflowSchemeService{
+				JiraServerBase: client,
+			}
+
+			createdScheme, err := workflowSchemeService.Create(ctx, models2.WorkflowSchemeCreateRequestModel{
+				Name:              name,
+				Description:       description,
+				DefaultWorkflow:   defaultWorkflow,
+				IssueTypeMappings: issueTypeMappings,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(createdScheme.Id, 10))
+			log.Println("success create workflow scheme")
+			return WorkflowSchemeResource().ReadContext(ctx, data, i)
+		},
+		ReadContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			schemeId, err := strconv.ParseInt(data.Id(), 10, 64)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			workflowSchemeService := workflowschemeservice.WorkflowSchemeService{
+				JiraServerBase: client,
+			}
+
+			foundScheme, err := workflowSchemeService.Get(ctx, models2.WorkflowSchemeGetRequestModel{
+				Id: schemeId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("name", foundScheme.Name); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("description", foundScheme.Description); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("default_workflow", foundScheme.DefaultWorkflow); err != nil {
+				return diag.FromErr(err)
+			}
+
+			mappings := []interface{}{}
+			for issueTypeId, workflow := range foundScheme.IssueTypeMappings {
+				param, _ := strconv.Atoi(issueTypeId)
+				mappings = append(mappings, map[string]interface{}{
+					"issue_type_id": param,
+					"workflow":      workflow,
+				})
+			}
+			if err = data.Set("issue_type_mapping", mappings); err != nil {
+				return diag.FromErr(err)
+			}
+
+			if err = data.Set("workflow_scheme_id", int(foundScheme.Id)); err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId(strconv.FormatInt(foundScheme.Id, 10))
+			log.Println("success get workflow scheme")
+			return diags
+		},
+		UpdateContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			client := i.(models.JiraServerBase)
+
+			schemeId, err := strconv.ParseInt(data.Id(), 10, 64)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			name := data.Get("name").(string)
+			description := data.Get("description").(string)
+			defaultWorkflow := data.Get("default_workflow").(string)
+			issueTypeMappings := expandWorkflowSchemeMappings(data.Get("issue_type_mapping").(*schema.Set).List())
+
+			statusMappings := []models2.WorkflowSchemeStatusMappingModel{}
+			for _, raw := range data.Get("status_mapping").(*schema.Set).List() {
+				mapping := raw.(map[string]interface{})
+				statusMappings = append(statusMappings, models2.WorkflowSchemeStatusMappingModel{
+					IssueTypeId: strconv.Itoa(mapping["issue_type_id"].(int)),
+					StatusId:    mapping["status_id"].(string),
+					NewStatusId: mapping["new_status_id"].(string),
+				})
+			}
+
+			workflowSchemeService := workflowschemeservice.WorkflowSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err = workflowSchemeService.Update(ctx, models2.WorkflowSchemeUpdateRequestModel{
+				Id:                schemeId,
+				Name:              name,
+				Description:       description,
+				DefaultWorkflow:   defaultWorkflow,
+				IssueTypeMappings: issueTypeMappings,
+				StatusMappings:    statusMappings,
+				MigrationTimeout:  data.Timeout(schema.TimeoutUpdate),
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			log.Println("success update workflow scheme")
+			return WorkflowSchemeResource().ReadContext(ctx, data, i)
+		},
+		DeleteContext: func(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+			var diags diag.Diagnostics
+			client := i.(models.JiraServerBase)
+
+			schemeId, err := strconv.ParseInt(data.Id(), 10, 64)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			workflowSchemeService := workflowschemeservice.WorkflowSchemeService{
+				JiraServerBase: client,
+			}
+
+			_, err = workflowSchemeService.Delete(ctx, models2.WorkflowSchemeDeleteRequestModel{
+				Id: schemeId,
+			})
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			data.SetId("")
+			log.Println("success delete workflow scheme")
+			return diags
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(workflowschemeservice.DefaultMigrationTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name of workflow scheme",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "description of workflow scheme",
+			},
+			"default_workflow": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "name of workflow used for unmapped issue types, defaults to jira",
+			},
+			"issue_type_mapping": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "issue type to workflow mappings",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issue_type_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "id of issue type",
+						},
+						"workflow": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "name of workflow",
+						},
+					},
+				},
+			},
+			"status_mapping": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "status migrations applied when publishing the draft of a scheme used by projects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"issue_type_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "id of issue type",
+						},
+						"status_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "id of status in the current workflow",
+						},
+						"new_status_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "id of status in the new workflow",
+						},
+					},
+				},
+			},
+			"workflow_scheme_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "id of workflow scheme",
+			},
+		},
+	}
+}
+
+func expandWorkflowSchemeMappings(mappings []interface{}) map[string]string {
+	result := map[string]string{}
+	for _, raw := range mappings {
+		mapping := raw.(map[string]interface{})
+		result[strconv.Itoa(mapping["issue_type_id"].(int))] = mapping["workflow"].(string)
+	}
+	return result
+}
@@ -0,0 +1,159 @@
+package agileservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/agileservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+type IAgileService interface {
+	ListBoards(ctx context.Context, model models.BoardListRequestModel) (models.BoardListResponseModel, error)
+	GetBoard(ctx context.Context, model models.BoardGetRequestModel) (models.BoardGetResponseModel, error)
+	CreateBoard(ctx context.Context, model models.BoardCreateRequestModel) (models.BoardCreateResponseModel, error)
+	DeleteBoard(ctx context.Context, model models.BoardDeleteRequestModel) (models.BoardDeleteResponseModel, error)
+	GetBoardConfiguration(ctx context.Context, model models.BoardConfigurationGetRequestModel) (models.BoardConfigurationGetResponseModel, error)
+}
+
+type AgileService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s AgileService) ListBoards(ctx context.Context, model models.BoardListRequestModel) (models.BoardListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list boards w. data: %v", model))
+
+	result := models.BoardListResponseModel{}
+	startAt := 0
+	for {
+		query := url2.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		if model.Name != "" {
+			query.Set("name", model.Name)
+		}
+
+		body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/agile/1.0/board?"+query.Encode(), nil)
+		if err != nil {
+			log.Println("failed to list boards")
+			return *new(models.BoardListResponseModel), err
+		}
+
+		page := models.BoardListApiResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.BoardListResponseModel), errors.New("error unmarshalling response body")
+		}
+
+		result.Boards = append(result.Boards, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	tflog.Info(ctx, "success list boards")
+	return result, nil
+}
+
+func (s AgileService) GetBoard(ctx context.Context, model models.BoardGetRequestModel) (models.BoardGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get board w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/agile/1.0/board/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "board not found")
+		return *new(models.BoardGetResponseModel), errors.New("failed to find board " + model.Id)
+	}
+	if err != nil {
+		log.Println("failed to get board")
+		return *new(models.BoardGetResponseModel), err
+	}
+
+	result := models.BoardGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.BoardGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// the board itself does not report its filter, only its configuration does
+	configuration, err := s.GetBoardConfiguration(ctx, models.BoardConfigurationGetRequestModel{
+		BoardId: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get board configuration")
+		return *new(models.BoardGetResponseModel), err
+	}
+	result.FilterId = configuration.Filter.Id
+
+	tflog.Info(ctx, "success get board")
+	return result, nil
+}
+
+func (s AgileService) CreateBoard(ctx context.Context, model models.BoardCreateRequestModel) (models.BoardCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create board w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPost, "/rest/agile/1.0/board", model)
+	if err != nil {
+		log.Println("failed to create board")
+		return *new(models.BoardCreateResponseModel), err
+	}
+
+	result := models.BoardCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.BoardCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create board")
+	return result, nil
+}
+
+func (s AgileService) DeleteBoard(ctx context.Context, model models.BoardDeleteRequestModel) (models.BoardDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete board w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, "/rest/agile/1.0/board/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "board already deleted")
+		return models.BoardDeleteResponseModel{}, nil
+	}
+	if err != nil {
+		log.Println("failed to delete board")
+		return *new(models.BoardDeleteResponseModel), err
+	}
+
+	tflog.Info(ctx, "success delete board")
+	return models.BoardDeleteResponseModel{}, nil
+}
+
+func (s AgileService) GetBoardConfiguration(ctx context.Context, model models.BoardConfigurationGetRequestModel) (models.BoardConfigurationGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get board configuration w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/agile/1.0/board/"+url2.PathEscape(model.BoardId)+"/configuration", nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "board configuration not found")
+		return *new(models.BoardConfigurationGetResponseModel), errors.New("failed to find configuration of board " + model.BoardId)
+	}
+	if err != nil {
+		log.Println("failed to get board configuration")
+		return *new(models.BoardConfigurationGetResponseModel), err
+	}
+
+	result := models.BoardConfigurationGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.BoardConfigurationGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get board configuration")
+	return result, nil
+}
@@ -0,0 +1,5 @@
+package models
+
+type BoardConfigurationGetRequestModel struct {
+	BoardId string
+}
@@ -0,0 +1,41 @@
+package models
+
+type BoardConfigurationGetResponseModel struct {
+	Id           int64                      `json:"id"`
+	Name         string                     `json:"name"`
+	Type         string                     `json:"type"`
+	Filter       BoardConfigurationRefModel `json:"filter"`
+	ColumnConfig BoardColumnConfigModel     `json:"columnConfig"`
+	Estimation   BoardEstimationModel       `json:"estimation"`
+	Ranking      BoardRankingModel          `json:"ranking"`
+}
+
+type BoardConfigurationRefModel struct {
+	Id string `json:"id"`
+}
+
+type BoardColumnConfigModel struct {
+	Columns        []BoardColumnModel `json:"columns"`
+	ConstraintType string             `json:"constraintType"`
+}
+
+type BoardColumnModel struct {
+	Name     string                       `json:"name"`
+	Statuses []BoardConfigurationRefModel `json:"statuses"`
+	Min      int64                        `json:"min"`
+	Max      int64                        `json:"max"`
+}
+
+type BoardEstimationModel struct {
+	Type  string                    `json:"type"`
+	Field BoardEstimationFieldModel `json:"field"`
+}
+
+type BoardEstimationFieldModel struct {
+	FieldId     string `json:"fieldId"`
+	DisplayName string `json:"displayName"`
+}
+
+type BoardRankingModel struct {
+	RankCustomFieldId int64 `json:"rankCustomFieldId"`
+}
@@ -0,0 +1,13 @@
+package models
+
+type BoardCreateRequestModel struct {
+	Name     string                        `json:"name"`
+	Type     string                        `json:"type"`
+	FilterId int64                         `json:"filterId"`
+	Location *BoardLocationApiRequestModel `json:"location,omitempty"`
+}
+
+type BoardLocationApiRequestModel struct {
+	Type           string `json:"type"`
+	ProjectKeyOrId string `json:"projectKeyOrId"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type BoardCreateResponseModel struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
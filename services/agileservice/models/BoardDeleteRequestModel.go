@@ -0,0 +1,5 @@
+package models
+
+type BoardDeleteRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type BoardGetRequestModel struct {
+	Id string
+}
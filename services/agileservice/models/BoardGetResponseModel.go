@@ -0,0 +1,14 @@
+package models
+
+type BoardGetResponseModel struct {
+	Id       int64              `json:"id"`
+	Name     string             `json:"name"`
+	Type     string             `json:"type"`
+	Location BoardLocationModel `json:"location"`
+	FilterId string             `json:"-"`
+}
+
+type BoardLocationModel struct {
+	ProjectId  int64  `json:"projectId"`
+	ProjectKey string `json:"projectKey"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type BoardListRequestModel struct {
+	Name string
+}
@@ -0,0 +1,12 @@
+package models
+
+type BoardListResponseModel struct {
+	Boards []BoardGetResponseModel
+}
+
+type BoardListApiResponseModel struct {
+	StartAt    int                     `json:"startAt"`
+	MaxResults int                     `json:"maxResults"`
+	IsLast     bool                    `json:"isLast"`
+	Values     []BoardGetResponseModel `json:"values"`
+}
@@ -0,0 +1,129 @@
+package announcementbannerservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"html"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"regexp"
+	"strings"
+	"terraform-provider-hashicups-pf/services/announcementbannerservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+// jira server versions without the banner rest api keep the banner on an admin page
+var (
+	announcementPattern = regexp.MustCompile(`(?s)<textarea[^>]*name="announcement"[^>]*>(.*?)</textarea>`)
+	visibilityPattern   = regexp.MustCompile(`<input[^>]*name="bannerVisibility"[^>]*>`)
+)
+
+type IAnnouncementBannerService interface {
+	Get(ctx context.Context, model models.AnnouncementBannerGetRequestModel) (models.AnnouncementBannerGetResponseModel, error)
+	Update(ctx context.Context, model models.AnnouncementBannerUpdateRequestModel) (models.AnnouncementBannerUpdateResponseModel, error)
+}
+
+type AnnouncementBannerService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s AnnouncementBannerService) Get(ctx context.Context, model models.AnnouncementBannerGetRequestModel) (models.AnnouncementBannerGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get announcement banner w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/announcementBanner", nil)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return s.getFromAdminPage(ctx)
+	}
+	if err != nil {
+		log.Println("failed to get announcement banner")
+		return *new(models.AnnouncementBannerGetResponseModel), err
+	}
+
+	result := models.AnnouncementBannerGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.AnnouncementBannerGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get announcement banner")
+	return result, nil
+}
+
+func (s AnnouncementBannerService) Update(ctx context.Context, model models.AnnouncementBannerUpdateRequestModel) (models.AnnouncementBannerUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update announcement banner w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/announcementBanner", model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return s.updateAdminPage(ctx, model)
+	}
+	if err != nil {
+		log.Println("failed to update announcement banner")
+		return *new(models.AnnouncementBannerUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update announcement banner")
+	return models.AnnouncementBannerUpdateResponseModel{}, nil
+}
+
+func (s AnnouncementBannerService) getFromAdminPage(ctx context.Context) (models.AnnouncementBannerGetResponseModel, error) {
+	body, err := baseservice.SendForm(ctx, s.JiraServerBase, http.MethodGet, "/secure/admin/EditAnnouncementBanner!default.jspa", nil)
+	if err != nil {
+		log.Println("failed to get announcement banner page")
+		return *new(models.AnnouncementBannerGetResponseModel), err
+	}
+
+	match := announcementPattern.FindStringSubmatch(string(body))
+	if match == nil {
+		return *new(models.AnnouncementBannerGetResponseModel), errors.New("failed to read announcement banner page, the token needs jira administrator rights")
+	}
+
+	// the admin page has no enabled flag, an empty message hides the banner
+	result := models.AnnouncementBannerGetResponseModel{
+		Message:    strings.TrimSpace(html.UnescapeString(match[1])),
+		Visibility: "private",
+	}
+	result.IsEnabled = result.Message != ""
+	for _, input := range visibilityPattern.FindAllString(string(body), -1) {
+		if strings.Contains(input, "checked") && strings.Contains(input, `value="public"`) {
+			result.Visibility = "public"
+		}
+	}
+
+	tflog.Info(ctx, "success get announcement banner")
+	return result, nil
+}
+
+func (s AnnouncementBannerService) updateAdminPage(ctx context.Context, model models.AnnouncementBannerUpdateRequestModel) (models.AnnouncementBannerUpdateResponseModel, error) {
+	message := ""
+	if model.IsEnabled {
+		message = model.Message
+	}
+	form := url2.Values{}
+	form.Set("announcement", message)
+	form.Set("bannerVisibility", model.Visibility)
+
+	_, err := baseservice.SendForm(ctx, s.JiraServerBase, http.MethodPost, "/secure/admin/EditAnnouncementBanner.jspa", form)
+	if err != nil {
+		log.Println("failed to update announcement banner page")
+		return *new(models.AnnouncementBannerUpdateResponseModel), err
+	}
+
+	// the page answers 200 with the form again on validation errors, so the write is checked by reading it back
+	foundBanner, err := s.getFromAdminPage(ctx)
+	if err != nil {
+		log.Println("failed to get announcement banner page")
+		return *new(models.AnnouncementBannerUpdateResponseModel), err
+	}
+	if foundBanner.Message != strings.TrimSpace(message) || foundBanner.Visibility != model.Visibility {
+		return *new(models.AnnouncementBannerUpdateResponseModel), errors.New("jira did not update the announcement banner, check the message and that visibility is public or private")
+	}
+
+	tflog.Info(ctx, "success update announcement banner")
+	return models.AnnouncementBannerUpdateResponseModel{}, nil
+}
@@ -0,0 +1,8 @@
+package models
+
+type AnnouncementBannerUpdateRequestModel struct {
+	Message       string `json:"message"`
+	Visibility    string `json:"visibility"`
+	IsEnabled     bool   `json:"isEnabled"`
+	IsDismissible bool   `json:"isDismissible"`
+}
@@ -0,0 +1,92 @@
+package applicationpropertyservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/applicationpropertyservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+type IApplicationPropertyService interface {
+	Get(ctx context.Context, model models.ApplicationPropertyGetRequestModel) (models.ApplicationPropertyGetResponseModel, error)
+	List(ctx context.Context, model models.ApplicationPropertyListRequestModel) (models.ApplicationPropertyListResponseModel, error)
+	Update(ctx context.Context, model models.ApplicationPropertyUpdateRequestModel) (models.ApplicationPropertyUpdateResponseModel, error)
+}
+
+type ApplicationPropertyService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s ApplicationPropertyService) Get(ctx context.Context, model models.ApplicationPropertyGetRequestModel) (models.ApplicationPropertyGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get application property w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/application-properties?key="+url2.QueryEscape(model.Key), nil)
+	if err != nil {
+		log.Println("failed to get application property")
+		return *new(models.ApplicationPropertyGetResponseModel), err
+	}
+
+	// depending on the jira version a key lookup returns the property itself or a list holding it
+	properties := []models.ApplicationPropertyGetResponseModel{}
+	if len(body) > 0 && body[0] == '[' {
+		err = json.Unmarshal(body, &properties)
+	} else {
+		property := models.ApplicationPropertyGetResponseModel{}
+		err = json.Unmarshal(body, &property)
+		properties = append(properties, property)
+	}
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ApplicationPropertyGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	for _, property := range properties {
+		if property.Key == model.Key {
+			tflog.Info(ctx, "success get application property")
+			return property, nil
+		}
+	}
+
+	tflog.Info(ctx, "application property not found")
+	return *new(models.ApplicationPropertyGetResponseModel), errors.New("failed to find application property " + model.Key)
+}
+
+func (s ApplicationPropertyService) List(ctx context.Context, model models.ApplicationPropertyListRequestModel) (models.ApplicationPropertyListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list advanced settings w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/application-properties/advanced-settings", nil)
+	if err != nil {
+		log.Println("failed to list advanced settings")
+		return *new(models.ApplicationPropertyListResponseModel), err
+	}
+
+	result := models.ApplicationPropertyListResponseModel{}
+	err = json.Unmarshal(body, &result.Properties)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ApplicationPropertyListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list advanced settings")
+	return result, nil
+}
+
+func (s ApplicationPropertyService) Update(ctx context.Context, model models.ApplicationPropertyUpdateRequestModel) (models.ApplicationPropertyUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update application property w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/application-properties/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update application property")
+		return *new(models.ApplicationPropertyUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update application property")
+	return models.ApplicationPropertyUpdateResponseModel{}, nil
+}
@@ -0,0 +1,5 @@
+package models
+
+type ApplicationPropertyGetRequestModel struct {
+	Key string
+}
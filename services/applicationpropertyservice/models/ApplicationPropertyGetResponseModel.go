@@ -0,0 +1,12 @@
+package models
+
+type ApplicationPropertyGetResponseModel struct {
+	Id            string   `json:"id"`
+	Key           string   `json:"key"`
+	Value         string   `json:"value"`
+	Name          string   `json:"name"`
+	Desc          string   `json:"desc"`
+	Type          string   `json:"type"`
+	DefaultValue  string   `json:"defaultValue"`
+	AllowedValues []string `json:"allowedValues"`
+}
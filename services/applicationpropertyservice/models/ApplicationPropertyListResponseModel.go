@@ -0,0 +1,5 @@
+package models
+
+type ApplicationPropertyListResponseModel struct {
+	Properties []ApplicationPropertyGetResponseModel
+}
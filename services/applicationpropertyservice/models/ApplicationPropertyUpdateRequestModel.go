@@ -0,0 +1,6 @@
+package models
+
+type ApplicationPropertyUpdateRequestModel struct {
+	Id    string `json:"id"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,60 @@
+package applicationroleservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"sort"
+	"terraform-provider-hashicups-pf/services/applicationroleservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+type IApplicationRoleService interface {
+	Get(ctx context.Context, model models.ApplicationRoleGetRequestModel) (models.ApplicationRoleGetResponseModel, error)
+	Update(ctx context.Context, model models.ApplicationRoleUpdateRequestModel) (models.ApplicationRoleUpdateResponseModel, error)
+}
+
+type ApplicationRoleService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s ApplicationRoleService) Get(ctx context.Context, model models.ApplicationRoleGetRequestModel) (models.ApplicationRoleGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get application role w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/applicationrole/"+url2.PathEscape(model.Key), nil)
+	if err != nil {
+		log.Println("failed to get application role")
+		return *new(models.ApplicationRoleGetResponseModel), err
+	}
+
+	result := models.ApplicationRoleGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ApplicationRoleGetResponseModel), errors.New("error unmarshalling response body")
+	}
+	sort.Strings(result.Groups)
+	sort.Strings(result.DefaultGroups)
+
+	tflog.Info(ctx, "success get application role")
+	return result, nil
+}
+
+func (s ApplicationRoleService) Update(ctx context.Context, model models.ApplicationRoleUpdateRequestModel) (models.ApplicationRoleUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update application role w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/applicationrole/"+url2.PathEscape(model.Key), model)
+	if err != nil {
+		log.Println("failed to update application role")
+		return *new(models.ApplicationRoleUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update application role")
+	return models.ApplicationRoleUpdateResponseModel{}, nil
+}
@@ -0,0 +1,5 @@
+package models
+
+type ApplicationRoleGetRequestModel struct {
+	Key string
+}
@@ -0,0 +1,13 @@
+package models
+
+type ApplicationRoleGetResponseModel struct {
+	Key               string   `json:"key"`
+	Name              string   `json:"name"`
+	Groups            []string `json:"groups"`
+	DefaultGroups     []string `json:"defaultGroups"`
+	SelectedByDefault bool     `json:"selectedByDefault"`
+	Defined           bool     `json:"defined"`
+	NumberOfSeats     int      `json:"numberOfSeats"`
+	RemainingSeats    int      `json:"remainingSeats"`
+	UserCount         int      `json:"userCount"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type ApplicationRoleUpdateRequestModel struct {
+	Key               string   `json:"key"`
+	Groups            []string `json:"groups"`
+	DefaultGroups     []string `json:"defaultGroups"`
+	SelectedByDefault bool     `json:"selectedByDefault"`
+}
@@ -0,0 +1,127 @@
+package avatarservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/avatarservice/models"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+)
+
+type IAvatarService interface {
+	List(ctx context.Context, model models.AvatarListRequestModel) (models.AvatarListResponseModel, error)
+	Get(ctx context.Context, model models.AvatarGetRequestModel) (models.AvatarGetResponseModel, error)
+	Create(ctx context.Context, model models.AvatarCreateRequestModel) (models.AvatarCreateResponseModel, error)
+	Delete(ctx context.Context, model models.AvatarDeleteRequestModel) (models.AvatarDeleteResponseModel, error)
+}
+
+type AvatarService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (a AvatarService) List(ctx context.Context, model models.AvatarListRequestModel) (models.AvatarListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list avatars w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, a.JiraServerBase, http.MethodGet, "/rest/api/2/universal_avatar/type/"+url2.PathEscape(model.Type)+"/owner/"+url2.PathEscape(model.OwnerId), nil)
+	if err != nil {
+		log.Println("failed to list avatars")
+		return *new(models.AvatarListResponseModel), err
+	}
+
+	result := models.AvatarListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.AvatarListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list avatars")
+	return result, nil
+}
+
+func (a AvatarService) Get(ctx context.Context, model models.AvatarGetRequestModel) (models.AvatarGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get avatar w. data: %v", model))
+
+	avatars, err := a.List(ctx, models.AvatarListRequestModel{
+		Type:    model.Type,
+		OwnerId: model.OwnerId,
+	})
+	if err != nil {
+		log.Println("failed to list avatars")
+		return *new(models.AvatarGetResponseModel), err
+	}
+
+	foundAvatar := models.AvatarGetResponseModel{}
+	for _, avatar := range append(avatars.Custom, avatars.System...) {
+		if avatar.Id == model.Id {
+			foundAvatar = avatar
+			break
+		}
+	}
+	if foundAvatar.Id == "" {
+		tflog.Info(ctx, "avatar not found")
+		return *new(models.AvatarGetResponseModel), errors.New("failed to find avatar " + model.Id)
+	}
+
+	tflog.Info(ctx, "success get avatar")
+	return foundAvatar, nil
+}
+
+func (a AvatarService) Create(ctx context.Context, model models.AvatarCreateRequestModel) (models.AvatarCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create avatar w. type: %s, owner: %s, file: %s", model.Type, model.OwnerId, model.FileName))
+
+	basePath := "/rest/api/2/universal_avatar/type/" + url2.PathEscape(model.Type) + "/owner/" + url2.PathEscape(model.OwnerId)
+	body, err := baseservice.SendContent(ctx, a.JiraServerBase, http.MethodPost, basePath+"/temp?filename="+url2.QueryEscape(model.FileName)+"&size="+strconv.Itoa(len(model.Content)), model.ContentType, model.Content)
+	if err != nil {
+		log.Println("failed to upload temporary avatar")
+		return *new(models.AvatarCreateResponseModel), err
+	}
+
+	tempAvatar := models.AvatarTemporaryResponseModel{}
+	err = json.Unmarshal(body, &tempAvatar)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.AvatarCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	body, err = baseservice.Send(ctx, a.JiraServerBase, http.MethodPost, basePath+"/avatar", models.AvatarCropApiRequestModel{
+		CropperWidth:   tempAvatar.CropperWidth,
+		CropperOffsetX: tempAvatar.CropperOffsetX,
+		CropperOffsetY: tempAvatar.CropperOffsetY,
+		NeedsCropping:  tempAvatar.NeedsCropping,
+	})
+	if err != nil {
+		log.Println("failed to crop avatar")
+		return *new(models.AvatarCreateResponseModel), err
+	}
+
+	result := models.AvatarCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.AvatarCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create avatar")
+	return result, nil
+}
+
+func (a AvatarService) Delete(ctx context.Context, model models.AvatarDeleteRequestModel) (models.AvatarDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete avatar w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, a.JiraServerBase, http.MethodDelete, "/rest/api/2/universal_avatar/type/"+url2.PathEscape(model.Type)+"/owner/"+url2.PathEscape(model.OwnerId)+"/avatar/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete avatar")
+		return *new(models.AvatarDeleteResponseModel), err
+	}
+
+	log.Println("delete avatar success")
+	return models.AvatarDeleteResponseModel{}, nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type AvatarCreateRequestModel struct {
+	Type        string
+	OwnerId     string
+	FileName    string
+	ContentType string
+	Content     []byte
+}
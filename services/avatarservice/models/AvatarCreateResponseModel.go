@@ -0,0 +1,7 @@
+package models
+
+type AvatarCreateResponseModel struct {
+	Id             string `json:"id"`
+	Owner          string `json:"owner"`
+	IsSystemAvatar bool   `json:"isSystemAvatar"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type AvatarCropApiRequestModel struct {
+	CropperWidth   int64 `json:"cropperWidth"`
+	CropperOffsetX int64 `json:"cropperOffsetX"`
+	CropperOffsetY int64 `json:"cropperOffsetY"`
+	NeedsCropping  bool  `json:"needsCropping"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type AvatarDeleteRequestModel struct {
+	Type    string
+	OwnerId string
+	Id      string
+}
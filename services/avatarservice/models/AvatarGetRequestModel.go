@@ -0,0 +1,7 @@
+package models
+
+type AvatarGetRequestModel struct {
+	Type    string
+	OwnerId string
+	Id      string
+}
@@ -0,0 +1,6 @@
+package models
+
+type AvatarListRequestModel struct {
+	Type    string
+	OwnerId string
+}
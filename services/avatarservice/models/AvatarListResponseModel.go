@@ -0,0 +1,6 @@
+package models
+
+type AvatarListResponseModel struct {
+	System []AvatarGetResponseModel `json:"system"`
+	Custom []AvatarGetResponseModel `json:"custom"`
+}
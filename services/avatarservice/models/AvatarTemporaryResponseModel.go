@@ -0,0 +1,9 @@
+package models
+
+type AvatarTemporaryResponseModel struct {
+	CropperWidth   int64  `json:"cropperWidth"`
+	CropperOffsetX int64  `json:"cropperOffsetX"`
+	CropperOffsetY int64  `json:"cropperOffsetY"`
+	Url            string `json:"url"`
+	NeedsCropping  bool   `json:"needsCropping"`
+}
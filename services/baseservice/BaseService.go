@@ -0,0 +1,151 @@
+package baseservice
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice/models"
+	"time"
+)
+
+// ErrNotFound matches a ResponseError whose status is 404.
+var ErrNotFound = errors.New("not found")
+
+// ErrNotSupported is returned when the jira server does not offer the requested endpoint.
+var ErrNotSupported = errors.New("endpoint is not available on this jira server version")
+
+// ResponseError is returned by Send when jira answers with a status of 300 or above.
+type ResponseError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *ResponseError) Error() string {
+	return e.Method + " " + e.Path + " returned " + e.Status + ": " + e.Body
+}
+
+// Is lets errors.Is match ErrNotFound on 404 and ErrNotSupported on 405.
+func (e *ResponseError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound
+	case ErrNotSupported:
+		return e.StatusCode == http.StatusMethodNotAllowed
+	}
+	return false
+}
+
+// Send makes an authorized json request to the jira rest api and returns the response body.
+func Send(ctx context.Context, base models.JiraServerBase, method string, path string, payload interface{}) ([]byte, error) {
+	var reader io.Reader
+	if payload != nil {
+		serialized, err := json.Marshal(payload)
+		if err != nil {
+			log.Println("failed to marshal request body")
+			return nil, errors.New("error json marshal req body")
+		}
+		reader = bytes.NewReader(serialized)
+	}
+
+	req, err := http.NewRequest(method, "https://"+base.Domain+path, reader)
+	if err != nil {
+		tflog.Info(ctx, "error building http request")
+		return nil, errors.New("error building http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return do(ctx, base, req, path)
+}
+
+// SendContent uploads raw content, e.g. an image, and returns the json response body.
+func SendContent(ctx context.Context, base models.JiraServerBase, method string, path string, contentType string, content []byte) ([]byte, error) {
+	req, err := http.NewRequest(method, "https://"+base.Domain+path, bytes.NewReader(content))
+	if err != nil {
+		tflog.Info(ctx, "error building http request")
+		return nil, errors.New("error building http request")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", contentType)
+	return do(ctx, base, req, path)
+}
+
+// SendForm makes an authorized request to a jira admin page, the form goes into the query for GET and into the body otherwise.
+func SendForm(ctx context.Context, base models.JiraServerBase, method string, path string, form url2.Values) ([]byte, error) {
+	var reader io.Reader
+	url := "https://" + base.Domain + path
+	if method == http.MethodGet {
+		if len(form) > 0 {
+			url += "?" + form.Encode()
+		}
+	} else {
+		reader = strings.NewReader(form.Encode())
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	if err != nil {
+		tflog.Info(ctx, "error building http request")
+		return nil, errors.New("error building http request")
+	}
+	if reader != nil {
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+	return do(ctx, base, req, path)
+}
+
+func do(ctx context.Context, base models.JiraServerBase, req *http.Request, path string) ([]byte, error) {
+	tflog.Info(ctx, "start "+req.Method+" "+path)
+
+	req.Header.Set("Authorization", base.AuthorizationMethod+" "+base.Token)
+	req.Header.Set("X-Atlassian-Token", "no-check")
+
+	client := http.Client{
+		Timeout: time.Second * 30,
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		tflog.Info(ctx, "error result from http request")
+		return nil, errors.New("error result from http request " + err.Error())
+	}
+	defer res.Body.Close()
+
+	tflog.Info(ctx, res.Status)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		tflog.Info(ctx, "read all body failed")
+		return nil, errors.New("error reading response body")
+	}
+
+	tflog.Info(ctx, "response body: "+string(body))
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return nil, &ResponseError{
+			Method:     req.Method,
+			Path:       path,
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Body:       string(body),
+		}
+	}
+	return body, nil
+}
+
+// CheckEndpoint returns ErrNotSupported when jira answers a GET on path with 404 or 405, any other failure is returned as is.
+func CheckEndpoint(ctx context.Context, base models.JiraServerBase, path string) error {
+	_, err := Send(ctx, base, http.MethodGet, path, nil)
+	if errors.Is(err, ErrNotFound) || errors.Is(err, ErrNotSupported) {
+		tflog.Info(ctx, path+" not available")
+		return ErrNotSupported
+	}
+	return err
+}
@@ -0,0 +1,428 @@
+package customfieldservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"html"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"regexp"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/customfieldservice/models"
+)
+
+// jira server has no rest endpoint for editing fields, the admin form holds name and description
+var (
+	fieldNamePattern        = regexp.MustCompile(`<input[^>]*name="name"[^>]*>`)
+	fieldValuePattern       = regexp.MustCompile(`value="([^"]*)"`)
+	fieldDescriptionPattern = regexp.MustCompile(`(?s)<textarea[^>]*name="description"[^>]*>(.*?)</textarea>`)
+)
+
+type ICustomFieldService interface {
+	List(ctx context.Context, model models.CustomFieldListRequestModel) (models.CustomFieldListResponseModel, error)
+	Get(ctx context.Context, model models.CustomFieldGetRequestModel) (models.CustomFieldGetResponseModel, error)
+	Create(ctx context.Context, model models.CustomFieldCreateRequestModel) (models.CustomFieldCreateResponseModel, error)
+	Update(ctx context.Context, model models.CustomFieldUpdateRequestModel) (models.CustomFieldUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.CustomFieldDeleteRequestModel) (models.CustomFieldDeleteResponseModel, error)
+	GetContext(ctx context.Context, model models.CustomFieldContextGetRequestModel) (models.CustomFieldContextGetResponseModel, error)
+	CreateContext(ctx context.Context, model models.CustomFieldContextCreateRequestModel) (models.CustomFieldContextCreateResponseModel, error)
+	UpdateContext(ctx context.Context, model models.CustomFieldContextUpdateRequestModel) (models.CustomFieldContextUpdateResponseModel, error)
+	DeleteContext(ctx context.Context, model models.CustomFieldContextDeleteRequestModel) (models.CustomFieldContextDeleteResponseModel, error)
+	ListOptions(ctx context.Context, model models.CustomFieldOptionListRequestModel) (models.CustomFieldOptionListResponseModel, error)
+	SetOptions(ctx context.Context, model models.CustomFieldOptionSetRequestModel) (models.CustomFieldOptionListResponseModel, error)
+	CheckContextSupport(ctx context.Context) error
+}
+
+type CustomFieldService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (c CustomFieldService) List(ctx context.Context, model models.CustomFieldListRequestModel) (models.CustomFieldListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list fields w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodGet, "/rest/api/2/field", nil)
+	if err != nil {
+		log.Println("failed to list fields")
+		return *new(models.CustomFieldListResponseModel), err
+	}
+
+	result := models.CustomFieldListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.CustomFieldListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list fields")
+	return result, nil
+}
+
+func (c CustomFieldService) Get(ctx context.Context, model models.CustomFieldGetRequestModel) (models.CustomFieldGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get custom field w. data: %v", model))
+
+	fields, err := c.List(ctx, models.CustomFieldListRequestModel{})
+	if err != nil {
+		log.Println("failed to list fields")
+		return *new(models.CustomFieldGetResponseModel), err
+	}
+
+	foundField := models.CustomFieldGetResponseModel{}
+	for _, field := range fields {
+		if field.Id == model.Id && field.Custom {
+			foundField = field
+			break
+		}
+	}
+	if foundField.Id == "" {
+		tflog.Info(ctx, "custom field not found")
+		return *new(models.CustomFieldGetResponseModel), errors.New("failed to find custom field " + model.Id)
+	}
+
+	tflog.Info(ctx, "success get custom field")
+	return foundField, nil
+}
+
+func (c CustomFieldService) Create(ctx context.Context, model models.CustomFieldCreateRequestModel) (models.CustomFieldCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create custom field w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodPost, "/rest/api/2/field", model)
+	if err != nil {
+		log.Println("failed to create custom field")
+		return *new(models.CustomFieldCreateResponseModel), err
+	}
+
+	result := models.CustomFieldCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.CustomFieldCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create custom field")
+	return result, nil
+}
+
+func (c CustomFieldService) Update(ctx context.Context, model models.CustomFieldUpdateRequestModel) (models.CustomFieldUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update custom field w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodPut, "/rest/api/2/field/"+url2.PathEscape(model.Id), model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		// jira server has no rest endpoint for editing fields, only the admin form
+		return c.updateAdminPage(ctx, model)
+	}
+	if err != nil {
+		log.Println("failed to update custom field")
+		return *new(models.CustomFieldUpdateResponseModel), err
+	}
+
+	log.Println("success update custom field")
+	return models.CustomFieldUpdateResponseModel{}, nil
+}
+
+func (c CustomFieldService) updateAdminPage(ctx context.Context, model models.CustomFieldUpdateRequestModel) (models.CustomFieldUpdateResponseModel, error) {
+	form := url2.Values{}
+	form.Set("id", strings.TrimPrefix(model.Id, "customfield_"))
+	form.Set("name", model.Name)
+	form.Set("description", model.Description)
+	form.Set("searcher", model.SearcherKey)
+
+	_, err := baseservice.SendForm(ctx, c.JiraServerBase, http.MethodPost, "/secure/admin/EditCustomField.jspa", form)
+	if err != nil {
+		log.Println("failed to update custom field")
+		return *new(models.CustomFieldUpdateResponseModel), err
+	}
+
+	// the form answers validation errors and websudo prompts with a 200 page, check the field really changed
+	updatedField, err := c.getFromAdminPage(ctx, model.Id)
+	if err != nil {
+		return *new(models.CustomFieldUpdateResponseModel), err
+	}
+	if updatedField.Name != model.Name || updatedField.Description != model.Description {
+		return *new(models.CustomFieldUpdateResponseModel), errors.New("jira did not accept the update of custom field " + model.Id + ", check that the name is unique and websudo is disabled for the token")
+	}
+
+	tflog.Info(ctx, "success update custom field")
+	return models.CustomFieldUpdateResponseModel{}, nil
+}
+
+func (c CustomFieldService) getFromAdminPage(ctx context.Context, id string) (models.CustomFieldGetResponseModel, error) {
+	form := url2.Values{}
+	form.Set("id", strings.TrimPrefix(id, "customfield_"))
+	body, err := baseservice.SendForm(ctx, c.JiraServerBase, http.MethodGet, "/secure/admin/EditCustomField!default.jspa", form)
+	if err != nil {
+		return *new(models.CustomFieldGetResponseModel), err
+	}
+
+	page := string(body)
+	nameInput := fieldNamePattern.FindString(page)
+	if nameInput == "" {
+		return *new(models.CustomFieldGetResponseModel), errors.New("failed to read custom field " + id + " page, the token needs jira administrator rights")
+	}
+
+	result := models.CustomFieldGetResponseModel{
+		Id: id,
+	}
+	if match := fieldValuePattern.FindStringSubmatch(nameInput); match != nil {
+		result.Name = html.UnescapeString(match[1])
+	}
+	if match := fieldDescriptionPattern.FindStringSubmatch(page); match != nil {
+		result.Description = strings.TrimSpace(html.UnescapeString(match[1]))
+	}
+	return result, nil
+}
+
+func (c CustomFieldService) Delete(ctx context.Context, model models.CustomFieldDeleteRequestModel) (models.CustomFieldDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete custom field w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodDelete, "/rest/api/2/customFields?ids="+url2.QueryEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to delete custom field")
+		return *new(models.CustomFieldDeleteResponseModel), err
+	}
+
+	// the bulk delete answers 200 even when it refuses a field, the reason is in the body
+	result := models.CustomFieldDeleteApiResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.CustomFieldDeleteResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+	if reason, ok := result.NotDeletedCustomFields[model.Id]; ok {
+		return *new(models.CustomFieldDeleteResponseModel), errors.New("jira did not delete custom field " + model.Id + ": " + reason)
+	}
+
+	log.Println("delete custom field success")
+	return models.CustomFieldDeleteResponseModel{}, nil
+}
+
+func (c CustomFieldService) GetContext(ctx context.Context, model models.CustomFieldContextGetRequestModel) (models.CustomFieldContextGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get custom field context w. data: %v", model))
+
+	contexts := models.CustomFieldContextListResponseModel{}
+	err := c.getContextResource(ctx, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context?contextId="+url2.QueryEscape(model.Id), &contexts)
+	if err != nil {
+		return *new(models.CustomFieldContextGetResponseModel), err
+	}
+
+	foundContext := models.CustomFieldContextGetResponseModel{}
+	for _, fieldContext := range contexts.Values {
+		if fieldContext.Id == model.Id {
+			foundContext = fieldContext
+			break
+		}
+	}
+	if foundContext.Id == "" {
+		tflog.Info(ctx, "custom field context not found")
+		return *new(models.CustomFieldContextGetResponseModel), errors.New("failed to find custom field context " + model.Id)
+	}
+
+	projectMappings := models.CustomFieldContextMappingListResponseModel{}
+	err = c.getContextResource(ctx, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context/projectmapping?contextId="+url2.QueryEscape(model.Id), &projectMappings)
+	if err != nil {
+		return *new(models.CustomFieldContextGetResponseModel), err
+	}
+	foundContext.ProjectIds = []string{}
+	for _, mapping := range projectMappings.Values {
+		if mapping.ProjectId != "" {
+			foundContext.ProjectIds = append(foundContext.ProjectIds, mapping.ProjectId)
+		}
+	}
+
+	issueTypeMappings := models.CustomFieldContextMappingListResponseModel{}
+	err = c.getContextResource(ctx, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context/issuetypemapping?contextId="+url2.QueryEscape(model.Id), &issueTypeMappings)
+	if err != nil {
+		return *new(models.CustomFieldContextGetResponseModel), err
+	}
+	foundContext.IssueTypeIds = []string{}
+	for _, mapping := range issueTypeMappings.Values {
+		if mapping.IssueTypeId != "" {
+			foundContext.IssueTypeIds = append(foundContext.IssueTypeIds, mapping.IssueTypeId)
+		}
+	}
+
+	tflog.Info(ctx, "success get custom field context")
+	return foundContext, nil
+}
+
+func (c CustomFieldService) CreateContext(ctx context.Context, model models.CustomFieldContextCreateRequestModel) (models.CustomFieldContextCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create custom field context w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodPost, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context", model)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.CustomFieldContextCreateResponseModel), errors.New("custom field contexts are not supported by this jira server, or the field does not exist")
+	}
+	if err != nil {
+		log.Println("failed to create custom field context")
+		return *new(models.CustomFieldContextCreateResponseModel), err
+	}
+
+	result := models.CustomFieldContextCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.CustomFieldContextCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create custom field context")
+	return result, nil
+}
+
+func (c CustomFieldService) UpdateContext(ctx context.Context, model models.CustomFieldContextUpdateRequestModel) (models.CustomFieldContextUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update custom field context w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodPut, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update custom field context")
+		return *new(models.CustomFieldContextUpdateResponseModel), err
+	}
+
+	log.Println("success update custom field context")
+	return models.CustomFieldContextUpdateResponseModel{}, nil
+}
+
+func (c CustomFieldService) DeleteContext(ctx context.Context, model models.CustomFieldContextDeleteRequestModel) (models.CustomFieldContextDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete custom field context w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodDelete, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete custom field context")
+		return *new(models.CustomFieldContextDeleteResponseModel), err
+	}
+
+	log.Println("delete custom field context success")
+	return models.CustomFieldContextDeleteResponseModel{}, nil
+}
+
+func (c CustomFieldService) ListOptions(ctx context.Context, model models.CustomFieldOptionListRequestModel) (models.CustomFieldOptionListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list custom field options w. data: %v", model))
+
+	result := models.CustomFieldOptionListResponseModel{}
+	err := c.getContextResource(ctx, "/rest/api/2/field/"+url2.PathEscape(model.FieldId)+"/context/"+url2.PathEscape(model.ContextId)+"/option?maxResults=1000", &result)
+	if err != nil {
+		return *new(models.CustomFieldOptionListResponseModel), err
+	}
+
+	tflog.Info(ctx, "success list custom field options")
+	return result, nil
+}
+
+// SetOptions makes the options of a context match model.Options exactly, options are matched by value so
+// existing option ids survive a reorder or a change of the disabled flag.
+func (c CustomFieldService) SetOptions(ctx context.Context, model models.CustomFieldOptionSetRequestModel) (models.CustomFieldOptionListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start set custom field options w. data: %v", model))
+
+	existing, err := c.ListOptions(ctx, models.CustomFieldOptionListRequestModel{
+		FieldId:   model.FieldId,
+		ContextId: model.ContextId,
+	})
+	if err != nil {
+		return *new(models.CustomFieldOptionListResponseModel), err
+	}
+
+	existingByValue := map[string]models.CustomFieldOptionModel{}
+	for _, option := range existing.Values {
+		existingByValue[option.Value] = option
+	}
+
+	toCreate := []models.CustomFieldOptionModel{}
+	toUpdate := []models.CustomFieldOptionModel{}
+	wanted := map[string]bool{}
+	for _, option := range model.Options {
+		wanted[option.Value] = true
+		found, ok := existingByValue[option.Value]
+		if !ok {
+			toCreate = append(toCreate, models.CustomFieldOptionModel{Value: option.Value, Disabled: option.Disabled})
+		} else if found.Disabled != option.Disabled {
+			toUpdate = append(toUpdate, models.CustomFieldOptionModel{Id: found.Id, Value: option.Value, Disabled: option.Disabled})
+		}
+	}
+
+	basePath := "/rest/api/2/field/" + url2.PathEscape(model.FieldId) + "/context/" + url2.PathEscape(model.ContextId) + "/option"
+	for _, option := range existing.Values {
+		if !wanted[option.Value] {
+			_, err = baseservice.Send(ctx, c.JiraServerBase, http.MethodDelete, basePath+"/"+url2.PathEscape(option.Id), nil)
+			if err != nil {
+				return *new(models.CustomFieldOptionListResponseModel), err
+			}
+		}
+	}
+
+	if len(toUpdate) > 0 {
+		_, err = baseservice.Send(ctx, c.JiraServerBase, http.MethodPut, basePath, models.CustomFieldOptionApiRequestModel{Options: toUpdate})
+		if err != nil {
+			return *new(models.CustomFieldOptionListResponseModel), err
+		}
+	}
+
+	if len(toCreate) > 0 {
+		_, err = baseservice.Send(ctx, c.JiraServerBase, http.MethodPost, basePath, models.CustomFieldOptionApiRequestModel{Options: toCreate})
+		if err != nil {
+			return *new(models.CustomFieldOptionListResponseModel), err
+		}
+	}
+
+	current, err := c.ListOptions(ctx, models.CustomFieldOptionListRequestModel{
+		FieldId:   model.FieldId,
+		ContextId: model.ContextId,
+	})
+	if err != nil {
+		return *new(models.CustomFieldOptionListResponseModel), err
+	}
+
+	currentByValue := map[string]string{}
+	for _, option := range current.Values {
+		currentByValue[option.Value] = option.Id
+	}
+	orderedIds := []string{}
+	for _, option := range model.Options {
+		orderedIds = append(orderedIds, currentByValue[option.Value])
+	}
+	if len(orderedIds) > 0 {
+		_, err = baseservice.Send(ctx, c.JiraServerBase, http.MethodPut, basePath+"/move", models.CustomFieldOptionMoveApiRequestModel{
+			CustomFieldOptionIds: orderedIds,
+			Position:             "First",
+		})
+		if err != nil {
+			return *new(models.CustomFieldOptionListResponseModel), err
+		}
+	}
+
+	tflog.Info(ctx, "success set custom field options")
+	return c.ListOptions(ctx, models.CustomFieldOptionListRequestModel{
+		FieldId:   model.FieldId,
+		ContextId: model.ContextId,
+	})
+}
+
+func (c CustomFieldService) CheckContextSupport(ctx context.Context) error {
+	err := baseservice.CheckEndpoint(ctx, c.JiraServerBase, "/rest/api/2/field/search?maxResults=1")
+	if errors.Is(err, baseservice.ErrNotSupported) {
+		return fmt.Errorf("custom field contexts and options need the field context rest endpoints: %w", err)
+	}
+	return err
+}
+
+func (c CustomFieldService) getContextResource(ctx context.Context, path string, result interface{}) error {
+	body, err := baseservice.Send(ctx, c.JiraServerBase, http.MethodGet, path, nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return errors.New("custom field contexts are not supported by this jira server, or the field does not exist")
+	}
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return errors.New("error unmarshalling response body")
+	}
+	return nil
+}
@@ -0,0 +1,9 @@
+package models
+
+type CustomFieldContextCreateRequestModel struct {
+	FieldId      string   `json:"-"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	ProjectIds   []string `json:"projectIds"`
+	IssueTypeIds []string `json:"issueTypeIds"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type CustomFieldContextDeleteRequestModel struct {
+	FieldId string
+	Id      string
+}
@@ -0,0 +1,6 @@
+package models
+
+type CustomFieldContextGetRequestModel struct {
+	FieldId string
+	Id      string
+}
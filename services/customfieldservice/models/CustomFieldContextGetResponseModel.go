@@ -0,0 +1,11 @@
+package models
+
+type CustomFieldContextGetResponseModel struct {
+	Id              string   `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	IsGlobalContext bool     `json:"isGlobalContext"`
+	IsAnyIssueType  bool     `json:"isAnyIssueType"`
+	ProjectIds      []string `json:"-"`
+	IssueTypeIds    []string `json:"-"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type CustomFieldContextListResponseModel struct {
+	Values []CustomFieldContextGetResponseModel `json:"values"`
+}
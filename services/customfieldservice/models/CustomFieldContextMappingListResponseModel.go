@@ -0,0 +1,12 @@
+package models
+
+type CustomFieldContextMappingListResponseModel struct {
+	Values []CustomFieldContextMappingModel `json:"values"`
+}
+
+type CustomFieldContextMappingModel struct {
+	ContextId       string `json:"contextId"`
+	ProjectId       string `json:"projectId"`
+	IssueTypeId     string `json:"issueTypeId"`
+	IsGlobalContext bool   `json:"isGlobalContext"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type CustomFieldContextUpdateRequestModel struct {
+	FieldId     string `json:"-"`
+	Id          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type CustomFieldCreateRequestModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	SearcherKey string `json:"searcherKey,omitempty"`
+}
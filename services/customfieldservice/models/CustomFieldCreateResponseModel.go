@@ -0,0 +1,8 @@
+package models
+
+type CustomFieldCreateResponseModel struct {
+	Id          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Schema      CustomFieldSchemaModel `json:"schema"`
+}
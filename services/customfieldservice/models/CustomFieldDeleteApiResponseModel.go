@@ -0,0 +1,6 @@
+package models
+
+type CustomFieldDeleteApiResponseModel struct {
+	DeletedCustomFields    []string          `json:"deletedCustomFields"`
+	NotDeletedCustomFields map[string]string `json:"notDeletedCustomFields"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type CustomFieldDeleteRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type CustomFieldGetRequestModel struct {
+	Id string
+}
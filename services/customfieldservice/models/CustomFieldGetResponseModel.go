@@ -0,0 +1,15 @@
+package models
+
+type CustomFieldGetResponseModel struct {
+	Id          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Custom      bool                   `json:"custom"`
+	Schema      CustomFieldSchemaModel `json:"schema"`
+}
+
+type CustomFieldSchemaModel struct {
+	Type     string `json:"type"`
+	Custom   string `json:"custom"`
+	CustomId int64  `json:"customId"`
+}
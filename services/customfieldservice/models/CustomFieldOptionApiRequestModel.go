@@ -0,0 +1,10 @@
+package models
+
+type CustomFieldOptionApiRequestModel struct {
+	Options []CustomFieldOptionModel `json:"options"`
+}
+
+type CustomFieldOptionMoveApiRequestModel struct {
+	CustomFieldOptionIds []string `json:"customFieldOptionIds"`
+	Position             string   `json:"position"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type CustomFieldOptionListRequestModel struct {
+	FieldId   string
+	ContextId string
+}
@@ -0,0 +1,5 @@
+package models
+
+type CustomFieldOptionListResponseModel struct {
+	Values []CustomFieldOptionModel `json:"values"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type CustomFieldOptionModel struct {
+	Id       string `json:"id,omitempty"`
+	Value    string `json:"value"`
+	Disabled bool   `json:"disabled"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type CustomFieldOptionSetRequestModel struct {
+	FieldId   string
+	ContextId string
+	Options   []CustomFieldOptionModel
+}
@@ -0,0 +1,8 @@
+package models
+
+type CustomFieldUpdateRequestModel struct {
+	Id          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SearcherKey string `json:"searcherKey,omitempty"`
+}
@@ -0,0 +1,165 @@
+package dashboardservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"sort"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/dashboardservice/models"
+)
+
+var ErrDashboardNotFound = errors.New("dashboard not found")
+
+type IDashboardService interface {
+	Get(ctx context.Context, model models.DashboardGetRequestModel) (models.DashboardGetResponseModel, error)
+	Create(ctx context.Context, model models.DashboardCreateRequestModel) (models.DashboardCreateResponseModel, error)
+	Update(ctx context.Context, model models.DashboardUpdateRequestModel) (models.DashboardUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.DashboardDeleteRequestModel) (models.DashboardDeleteResponseModel, error)
+	CheckSupport(ctx context.Context) error
+}
+
+type DashboardService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s DashboardService) Get(ctx context.Context, model models.DashboardGetRequestModel) (models.DashboardGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get dashboard w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/dashboard/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "dashboard not found")
+		return *new(models.DashboardGetResponseModel), ErrDashboardNotFound
+	}
+	if errors.Is(err, baseservice.ErrNotSupported) {
+		return *new(models.DashboardGetResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to get dashboard")
+		return *new(models.DashboardGetResponseModel), err
+	}
+
+	result := models.DashboardGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.DashboardGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	for _, permission := range result.SharePermissions {
+		share := models.DashboardShareModel{Type: permission.Type}
+		if permission.Group != nil {
+			share.GroupName = permission.Group.Name
+		}
+		if permission.Project != nil {
+			share.ProjectId = permission.Project.Id
+		}
+		if permission.Role != nil {
+			share.ProjectRoleId = strconv.FormatInt(permission.Role.Id, 10)
+			share.Type = "projectRole"
+		}
+		result.Shares = append(result.Shares, share)
+	}
+	sort.Slice(result.Shares, func(i, j int) bool {
+		return fmt.Sprintf("%v", result.Shares[i]) < fmt.Sprintf("%v", result.Shares[j])
+	})
+
+	tflog.Info(ctx, "success get dashboard")
+	return result, nil
+}
+
+func (s DashboardService) Create(ctx context.Context, model models.DashboardCreateRequestModel) (models.DashboardCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create dashboard w. data: %v", model))
+
+	payload := models.DashboardApiRequestModel{
+		Name:             model.Name,
+		Description:      model.Description,
+		SharePermissions: expandSharePermissions(model.Shares),
+	}
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPost, "/rest/api/2/dashboard", payload)
+	if err != nil {
+		log.Println("failed to create dashboard")
+		return *new(models.DashboardCreateResponseModel), err
+	}
+
+	result := models.DashboardCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.DashboardCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create dashboard")
+	return result, nil
+}
+
+func (s DashboardService) Update(ctx context.Context, model models.DashboardUpdateRequestModel) (models.DashboardUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update dashboard w. data: %v", model))
+
+	payload := models.DashboardApiRequestModel{
+		Name:             model.Name,
+		Description:      model.Description,
+		SharePermissions: expandSharePermissions(model.Shares),
+	}
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/dashboard/"+url2.PathEscape(model.Id), payload)
+	if err != nil {
+		log.Println("failed to update dashboard")
+		return *new(models.DashboardUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update dashboard")
+	return models.DashboardUpdateResponseModel{}, nil
+}
+
+func (s DashboardService) Delete(ctx context.Context, model models.DashboardDeleteRequestModel) (models.DashboardDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete dashboard w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, "/rest/api/2/dashboard/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "dashboard already deleted")
+		return models.DashboardDeleteResponseModel{}, nil
+	}
+	if err != nil {
+		log.Println("failed to delete dashboard")
+		return *new(models.DashboardDeleteResponseModel), err
+	}
+
+	tflog.Info(ctx, "success delete dashboard")
+	return models.DashboardDeleteResponseModel{}, nil
+}
+
+// CheckSupport probes the dashboard search endpoint, which ships together with the create and update endpoints
+func (s DashboardService) CheckSupport(ctx context.Context) error {
+	err := baseservice.CheckEndpoint(ctx, s.JiraServerBase, "/rest/api/2/dashboard/search?maxResults=1")
+	if err != nil {
+		return fmt.Errorf("jiraserverfatih_dashboard needs the dashboard create and update rest endpoints: %w", err)
+	}
+	return nil
+}
+
+func expandSharePermissions(shares []models.DashboardShareModel) []models.DashboardSharePermissionApiModel {
+	permissions := make([]models.DashboardSharePermissionApiModel, 0, len(shares))
+	for _, share := range shares {
+		permission := models.DashboardSharePermissionApiModel{Type: share.Type}
+		switch share.Type {
+		case "group":
+			permission.Group = &models.DashboardShareGroupApiModel{Name: share.GroupName}
+		case "project":
+			permission.Project = &models.DashboardShareProjectApiModel{Id: share.ProjectId}
+		case "projectRole":
+			permission.Type = "project"
+			permission.Project = &models.DashboardShareProjectApiModel{Id: share.ProjectId}
+			roleId, _ := strconv.ParseInt(share.ProjectRoleId, 10, 64)
+			permission.Role = &models.DashboardShareRoleApiModel{Id: roleId}
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions
+}
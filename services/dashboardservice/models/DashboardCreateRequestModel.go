@@ -0,0 +1,13 @@
+package models
+
+type DashboardCreateRequestModel struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Shares      []DashboardShareModel `json:"-"`
+}
+
+type DashboardApiRequestModel struct {
+	Name             string                             `json:"name"`
+	Description      string                             `json:"description"`
+	SharePermissions []DashboardSharePermissionApiModel `json:"sharePermissions"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type DashboardDeleteRequestModel struct {
+	Id string
+}
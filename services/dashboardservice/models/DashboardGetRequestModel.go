@@ -0,0 +1,5 @@
+package models
+
+type DashboardGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,35 @@
+package models
+
+type DashboardGetResponseModel struct {
+	Id               string                             `json:"id"`
+	Name             string                             `json:"name"`
+	Description      string                             `json:"description"`
+	SharePermissions []DashboardSharePermissionApiModel `json:"sharePermissions"`
+	Shares           []DashboardShareModel              `json:"-"`
+}
+
+type DashboardSharePermissionApiModel struct {
+	Type    string                         `json:"type"`
+	Group   *DashboardShareGroupApiModel   `json:"group,omitempty"`
+	Project *DashboardShareProjectApiModel `json:"project,omitempty"`
+	Role    *DashboardShareRoleApiModel    `json:"role,omitempty"`
+}
+
+type DashboardShareGroupApiModel struct {
+	Name string `json:"name"`
+}
+
+type DashboardShareProjectApiModel struct {
+	Id string `json:"id"`
+}
+
+type DashboardShareRoleApiModel struct {
+	Id int64 `json:"id"`
+}
+
+type DashboardShareModel struct {
+	Type          string
+	GroupName     string
+	ProjectId     string
+	ProjectRoleId string
+}
@@ -0,0 +1,8 @@
+package models
+
+type DashboardUpdateRequestModel struct {
+	Id          string                `json:"-"`
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Shares      []DashboardShareModel `json:"-"`
+}
@@ -0,0 +1,93 @@
+package entitypropertyservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/entitypropertyservice/models"
+)
+
+// entity types share the same properties api below /rest/api/2/{entityType}/{entityId}
+const (
+	EntityTypeProject   = "project"
+	EntityTypeIssueType = "issuetype"
+)
+
+type IEntityPropertyService interface {
+	Get(ctx context.Context, model models.EntityPropertyGetRequestModel) (models.EntityPropertyGetResponseModel, error)
+	Set(ctx context.Context, model models.EntityPropertySetRequestModel) (models.EntityPropertySetResponseModel, error)
+	Delete(ctx context.Context, model models.EntityPropertyDeleteRequestModel) (models.EntityPropertyDeleteResponseModel, error)
+}
+
+type EntityPropertyService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s EntityPropertyService) Get(ctx context.Context, model models.EntityPropertyGetRequestModel) (models.EntityPropertyGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get entity property w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, propertyPath(model.EntityType, model.EntityId, model.Key), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "entity property not found")
+		return *new(models.EntityPropertyGetResponseModel), errors.New("failed to find property " + model.Key + " of " + model.EntityType + " " + model.EntityId)
+	}
+	if err != nil {
+		log.Println("failed to get entity property")
+		return *new(models.EntityPropertyGetResponseModel), err
+	}
+
+	result := models.EntityPropertyGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.EntityPropertyGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get entity property")
+	return result, nil
+}
+
+func (s EntityPropertyService) Set(ctx context.Context, model models.EntityPropertySetRequestModel) (models.EntityPropertySetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start set entity property w. data: %v", model))
+
+	// the request body is the property value itself
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, propertyPath(model.EntityType, model.EntityId, model.Key), json.RawMessage(model.Value))
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.EntityPropertySetResponseModel), errors.New("failed to find " + model.EntityType + " " + model.EntityId)
+	}
+	if err != nil {
+		log.Println("failed to set entity property")
+		return *new(models.EntityPropertySetResponseModel), err
+	}
+
+	tflog.Info(ctx, "success set entity property")
+	return models.EntityPropertySetResponseModel{}, nil
+}
+
+func (s EntityPropertyService) Delete(ctx context.Context, model models.EntityPropertyDeleteRequestModel) (models.EntityPropertyDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete entity property w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, propertyPath(model.EntityType, model.EntityId, model.Key), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "entity property already deleted")
+		return models.EntityPropertyDeleteResponseModel{}, nil
+	}
+	if err != nil {
+		log.Println("failed to delete entity property")
+		return *new(models.EntityPropertyDeleteResponseModel), err
+	}
+
+	tflog.Info(ctx, "success delete entity property")
+	return models.EntityPropertyDeleteResponseModel{}, nil
+}
+
+func propertyPath(entityType string, entityId string, key string) string {
+	return "/rest/api/2/" + entityType + "/" + url2.PathEscape(entityId) + "/properties/" + url2.PathEscape(key)
+}
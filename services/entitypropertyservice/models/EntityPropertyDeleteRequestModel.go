@@ -0,0 +1,7 @@
+package models
+
+type EntityPropertyDeleteRequestModel struct {
+	EntityType string
+	EntityId   string
+	Key        string
+}
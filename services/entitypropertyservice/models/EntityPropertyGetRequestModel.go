@@ -0,0 +1,7 @@
+package models
+
+type EntityPropertyGetRequestModel struct {
+	EntityType string
+	EntityId   string
+	Key        string
+}
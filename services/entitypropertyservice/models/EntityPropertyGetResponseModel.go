@@ -0,0 +1,8 @@
+package models
+
+import "encoding/json"
+
+type EntityPropertyGetResponseModel struct {
+	Key   string          `json:"key"`
+	Value json.RawMessage `json:"value"`
+}
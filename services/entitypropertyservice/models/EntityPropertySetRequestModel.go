@@ -0,0 +1,8 @@
+package models
+
+type EntityPropertySetRequestModel struct {
+	EntityType string
+	EntityId   string
+	Key        string
+	Value      string
+}
@@ -0,0 +1,208 @@
+package fieldconfigurationschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/fieldconfigurationschemeservice/models"
+)
+
+type IFieldConfigurationSchemeService interface {
+	List(ctx context.Context, model models.FieldConfigurationSchemeListRequestModel) (models.FieldConfigurationSchemeListResponseModel, error)
+	Get(ctx context.Context, model models.FieldConfigurationSchemeGetRequestModel) (models.FieldConfigurationSchemeGetResponseModel, error)
+	Create(ctx context.Context, model models.FieldConfigurationSchemeCreateRequestModel) (models.FieldConfigurationSchemeCreateResponseModel, error)
+	Update(ctx context.Context, model models.FieldConfigurationSchemeUpdateRequestModel) (models.FieldConfigurationSchemeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.FieldConfigurationSchemeDeleteRequestModel) (models.FieldConfigurationSchemeDeleteResponseModel, error)
+}
+
+type FieldConfigurationSchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (f FieldConfigurationSchemeService) List(ctx context.Context, model models.FieldConfigurationSchemeListRequestModel) (models.FieldConfigurationSchemeListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list field configuration schemes w. data: %v", model))
+
+	path := "/rest/api/2/fieldconfigurationscheme"
+	if model.Id != "" {
+		path += "?id=" + url2.QueryEscape(model.Id)
+	}
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, path, nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.FieldConfigurationSchemeListResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to list field configuration schemes")
+		return *new(models.FieldConfigurationSchemeListResponseModel), err
+	}
+
+	result := models.FieldConfigurationSchemeListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FieldConfigurationSchemeListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list field configuration schemes")
+	return result, nil
+}
+
+func (f FieldConfigurationSchemeService) Get(ctx context.Context, model models.FieldConfigurationSchemeGetRequestModel) (models.FieldConfigurationSchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get field configuration scheme w. data: %v", model))
+
+	schemes, err := f.List(ctx, models.FieldConfigurationSchemeListRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to list field configuration schemes")
+		return *new(models.FieldConfigurationSchemeGetResponseModel), err
+	}
+
+	foundScheme := models.FieldConfigurationSchemeGetResponseModel{}
+	for _, scheme := range schemes.Values {
+		if scheme.Id == model.Id {
+			foundScheme = scheme
+			break
+		}
+	}
+	if foundScheme.Id == "" {
+		tflog.Info(ctx, "field configuration scheme not found")
+		return *new(models.FieldConfigurationSchemeGetResponseModel), errors.New("failed to find field configuration scheme " + model.Id)
+	}
+
+	mappings, err := f.listMappings(ctx, model.Id)
+	if err != nil {
+		log.Println("failed to list field configuration scheme mappings")
+		return *new(models.FieldConfigurationSchemeGetResponseModel), err
+	}
+	foundScheme.Mappings = mappings.Values
+
+	tflog.Info(ctx, "success get field configuration scheme")
+	return foundScheme, nil
+}
+
+func (f FieldConfigurationSchemeService) Create(ctx context.Context, model models.FieldConfigurationSchemeCreateRequestModel) (models.FieldConfigurationSchemeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create field configuration scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/fieldconfigurationscheme", model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return *new(models.FieldConfigurationSchemeCreateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to create field configuration scheme")
+		return *new(models.FieldConfigurationSchemeCreateResponseModel), err
+	}
+
+	result := models.FieldConfigurationSchemeCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FieldConfigurationSchemeCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	if len(model.Mappings) > 0 {
+		err = f.setMappings(ctx, result.Id, model.Mappings)
+		if err != nil {
+			log.Println("failed to set field configuration scheme mappings")
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create field configuration scheme")
+	return result, nil
+}
+
+func (f FieldConfigurationSchemeService) Update(ctx context.Context, model models.FieldConfigurationSchemeUpdateRequestModel) (models.FieldConfigurationSchemeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update field configuration scheme w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPut, "/rest/api/2/fieldconfigurationscheme/"+url2.PathEscape(model.Id), model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return *new(models.FieldConfigurationSchemeUpdateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to update field configuration scheme")
+		return *new(models.FieldConfigurationSchemeUpdateResponseModel), err
+	}
+
+	if len(model.RemovedIssueTypeIds) > 0 {
+		err = f.deleteMappings(ctx, model.Id, model.RemovedIssueTypeIds)
+		if err != nil {
+			log.Println("failed to delete field configuration scheme mappings")
+			return *new(models.FieldConfigurationSchemeUpdateResponseModel), err
+		}
+	}
+
+	if len(model.Mappings) > 0 {
+		err = f.setMappings(ctx, model.Id, model.Mappings)
+		if err != nil {
+			log.Println("failed to set field configuration scheme mappings")
+			return *new(models.FieldConfigurationSchemeUpdateResponseModel), err
+		}
+	}
+
+	log.Println("success update field configuration scheme")
+	return models.FieldConfigurationSchemeUpdateResponseModel{}, nil
+}
+
+func (f FieldConfigurationSchemeService) Delete(ctx context.Context, model models.FieldConfigurationSchemeDeleteRequestModel) (models.FieldConfigurationSchemeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete field configuration scheme w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodDelete, "/rest/api/2/fieldconfigurationscheme/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete field configuration scheme")
+		return *new(models.FieldConfigurationSchemeDeleteResponseModel), err
+	}
+
+	log.Println("delete field configuration scheme success")
+	return models.FieldConfigurationSchemeDeleteResponseModel{}, nil
+}
+
+func (f FieldConfigurationSchemeService) listMappings(ctx context.Context, schemeId string) (models.FieldConfigurationSchemeMappingListResponseModel, error) {
+	result := models.FieldConfigurationSchemeMappingListResponseModel{}
+	startAt := 0
+	for {
+		body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, "/rest/api/2/fieldconfigurationscheme/mapping?maxResults=50&fieldConfigurationSchemeId="+url2.QueryEscape(schemeId)+"&startAt="+strconv.Itoa(startAt), nil)
+		if errors.Is(err, baseservice.ErrNotFound) {
+			return *new(models.FieldConfigurationSchemeMappingListResponseModel), baseservice.ErrNotSupported
+		}
+		if err != nil {
+			return *new(models.FieldConfigurationSchemeMappingListResponseModel), err
+		}
+
+		page := models.FieldConfigurationSchemeMappingListResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.FieldConfigurationSchemeMappingListResponseModel), errors.New("error unmarshalling response body")
+		}
+
+		result.Values = append(result.Values, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	return result, nil
+}
+
+func (f FieldConfigurationSchemeService) setMappings(ctx context.Context, schemeId string, mappings []models.FieldConfigurationSchemeMappingModel) error {
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPut, "/rest/api/2/fieldconfigurationscheme/"+url2.PathEscape(schemeId)+"/mapping", models.FieldConfigurationSchemeMappingApiRequestModel{
+		Mappings: mappings,
+	})
+	return err
+}
+
+func (f FieldConfigurationSchemeService) deleteMappings(ctx context.Context, schemeId string, issueTypeIds []string) error {
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/fieldconfigurationscheme/"+url2.PathEscape(schemeId)+"/mapping/delete", models.FieldConfigurationSchemeMappingDeleteApiRequestModel{
+		IssueTypeIds: issueTypeIds,
+	})
+	return err
+}
@@ -0,0 +1,7 @@
+package models
+
+type FieldConfigurationSchemeCreateRequestModel struct {
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description"`
+	Mappings    []FieldConfigurationSchemeMappingModel `json:"-"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationSchemeDeleteRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationSchemeGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,8 @@
+package models
+
+type FieldConfigurationSchemeGetResponseModel struct {
+	Id          string                                 `json:"id"`
+	Name        string                                 `json:"name"`
+	Description string                                 `json:"description"`
+	Mappings    []FieldConfigurationSchemeMappingModel `json:"-"`
+}
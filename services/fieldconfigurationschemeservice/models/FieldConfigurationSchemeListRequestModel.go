@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationSchemeListRequestModel struct {
+	Id string
+}
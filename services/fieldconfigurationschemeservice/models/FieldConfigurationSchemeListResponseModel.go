@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationSchemeListResponseModel struct {
+	Values []FieldConfigurationSchemeGetResponseModel `json:"values"`
+}
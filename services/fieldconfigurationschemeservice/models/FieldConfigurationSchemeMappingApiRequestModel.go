@@ -0,0 +1,9 @@
+package models
+
+type FieldConfigurationSchemeMappingApiRequestModel struct {
+	Mappings []FieldConfigurationSchemeMappingModel `json:"mappings"`
+}
+
+type FieldConfigurationSchemeMappingDeleteApiRequestModel struct {
+	IssueTypeIds []string `json:"issueTypeIds"`
+}
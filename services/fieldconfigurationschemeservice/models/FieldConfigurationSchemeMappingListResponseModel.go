@@ -0,0 +1,6 @@
+package models
+
+type FieldConfigurationSchemeMappingListResponseModel struct {
+	IsLast bool                                   `json:"isLast"`
+	Values []FieldConfigurationSchemeMappingModel `json:"values"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type FieldConfigurationSchemeMappingModel struct {
+	IssueTypeId          string `json:"issueTypeId"`
+	FieldConfigurationId string `json:"fieldConfigurationId"`
+}
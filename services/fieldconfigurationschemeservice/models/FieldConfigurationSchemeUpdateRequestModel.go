@@ -0,0 +1,9 @@
+package models
+
+type FieldConfigurationSchemeUpdateRequestModel struct {
+	Id                  string                                 `json:"-"`
+	Name                string                                 `json:"name"`
+	Description         string                                 `json:"description"`
+	Mappings            []FieldConfigurationSchemeMappingModel `json:"-"`
+	RemovedIssueTypeIds []string                               `json:"-"`
+}
@@ -0,0 +1,203 @@
+package fieldconfigurationservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/fieldconfigurationservice/models"
+)
+
+type IFieldConfigurationService interface {
+	List(ctx context.Context, model models.FieldConfigurationListRequestModel) (models.FieldConfigurationListResponseModel, error)
+	Get(ctx context.Context, model models.FieldConfigurationGetRequestModel) (models.FieldConfigurationGetResponseModel, error)
+	Create(ctx context.Context, model models.FieldConfigurationCreateRequestModel) (models.FieldConfigurationCreateResponseModel, error)
+	Update(ctx context.Context, model models.FieldConfigurationUpdateRequestModel) (models.FieldConfigurationUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.FieldConfigurationDeleteRequestModel) (models.FieldConfigurationDeleteResponseModel, error)
+}
+
+type FieldConfigurationService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (f FieldConfigurationService) List(ctx context.Context, model models.FieldConfigurationListRequestModel) (models.FieldConfigurationListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list field configurations w. data: %v", model))
+
+	path := "/rest/api/2/fieldconfiguration"
+	if model.Id != "" {
+		path += "?id=" + url2.QueryEscape(model.Id)
+	}
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, path, nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.FieldConfigurationListResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to list field configurations")
+		return *new(models.FieldConfigurationListResponseModel), err
+	}
+
+	result := models.FieldConfigurationListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FieldConfigurationListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list field configurations")
+	return result, nil
+}
+
+func (f FieldConfigurationService) Get(ctx context.Context, model models.FieldConfigurationGetRequestModel) (models.FieldConfigurationGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get field configuration w. data: %v", model))
+
+	fieldConfigurations, err := f.List(ctx, models.FieldConfigurationListRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to list field configurations")
+		return *new(models.FieldConfigurationGetResponseModel), err
+	}
+
+	foundFieldConfiguration := models.FieldConfigurationGetResponseModel{}
+	for _, fieldConfiguration := range fieldConfigurations.Values {
+		if strconv.FormatInt(fieldConfiguration.Id, 10) == model.Id {
+			foundFieldConfiguration = fieldConfiguration
+			break
+		}
+	}
+	if foundFieldConfiguration.Id == 0 {
+		tflog.Info(ctx, "field configuration not found")
+		return *new(models.FieldConfigurationGetResponseModel), errors.New("failed to find field configuration " + model.Id)
+	}
+
+	items, err := f.listItems(ctx, model.Id)
+	if err != nil {
+		log.Println("failed to list field configuration items")
+		return *new(models.FieldConfigurationGetResponseModel), err
+	}
+	foundFieldConfiguration.Items = items.Values
+
+	tflog.Info(ctx, "success get field configuration")
+	return foundFieldConfiguration, nil
+}
+
+func (f FieldConfigurationService) Create(ctx context.Context, model models.FieldConfigurationCreateRequestModel) (models.FieldConfigurationCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create field configuration w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/fieldconfiguration", model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return *new(models.FieldConfigurationCreateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to create field configuration")
+		return *new(models.FieldConfigurationCreateResponseModel), err
+	}
+
+	result := models.FieldConfigurationCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FieldConfigurationCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	if len(model.Items) > 0 {
+		err = f.updateItems(ctx, strconv.FormatInt(result.Id, 10), model.Items)
+		if err != nil {
+			log.Println("failed to update field configuration items")
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create field configuration")
+	return result, nil
+}
+
+func (f FieldConfigurationService) Update(ctx context.Context, model models.FieldConfigurationUpdateRequestModel) (models.FieldConfigurationUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update field configuration w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPut, "/rest/api/2/fieldconfiguration/"+url2.PathEscape(model.Id), model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		return *new(models.FieldConfigurationUpdateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to update field configuration")
+		return *new(models.FieldConfigurationUpdateResponseModel), err
+	}
+
+	// fields that are no longer managed go back to jira's defaults
+	items := model.Items
+	for _, fieldId := range model.RemovedFields {
+		items = append(items, models.FieldConfigurationItemModel{
+			Id: fieldId,
+		})
+	}
+	if len(items) > 0 {
+		err = f.updateItems(ctx, model.Id, items)
+		if err != nil {
+			log.Println("failed to update field configuration items")
+			return *new(models.FieldConfigurationUpdateResponseModel), err
+		}
+	}
+
+	log.Println("success update field configuration")
+	return models.FieldConfigurationUpdateResponseModel{}, nil
+}
+
+func (f FieldConfigurationService) Delete(ctx context.Context, model models.FieldConfigurationDeleteRequestModel) (models.FieldConfigurationDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete field configuration w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodDelete, "/rest/api/2/fieldconfiguration/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete field configuration")
+		return *new(models.FieldConfigurationDeleteResponseModel), err
+	}
+
+	log.Println("delete field configuration success")
+	return models.FieldConfigurationDeleteResponseModel{}, nil
+}
+
+func (f FieldConfigurationService) listItems(ctx context.Context, fieldConfigurationId string) (models.FieldConfigurationItemListResponseModel, error) {
+	result := models.FieldConfigurationItemListResponseModel{}
+	startAt := 0
+	for {
+		body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, "/rest/api/2/fieldconfiguration/"+url2.PathEscape(fieldConfigurationId)+"/fields?maxResults=100&startAt="+strconv.Itoa(startAt), nil)
+		if errors.Is(err, baseservice.ErrNotFound) {
+			return *new(models.FieldConfigurationItemListResponseModel), baseservice.ErrNotSupported
+		}
+		if err != nil {
+			return *new(models.FieldConfigurationItemListResponseModel), err
+		}
+
+		page := models.FieldConfigurationItemListResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.FieldConfigurationItemListResponseModel), errors.New("error unmarshalling response body")
+		}
+
+		result.Values = append(result.Values, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	return result, nil
+}
+
+func (f FieldConfigurationService) updateItems(ctx context.Context, fieldConfigurationId string, items []models.FieldConfigurationItemModel) error {
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPut, "/rest/api/2/fieldconfiguration/"+url2.PathEscape(fieldConfigurationId)+"/fields", models.FieldConfigurationItemUpdateApiRequestModel{
+		FieldConfigurationItems: items,
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return baseservice.ErrNotSupported
+	}
+	return err
+}
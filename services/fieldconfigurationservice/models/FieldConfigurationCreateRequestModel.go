@@ -0,0 +1,7 @@
+package models
+
+type FieldConfigurationCreateRequestModel struct {
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	Items       []FieldConfigurationItemModel `json:"-"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type FieldConfigurationCreateResponseModel struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationDeleteRequestModel struct {
+	Id string
+}
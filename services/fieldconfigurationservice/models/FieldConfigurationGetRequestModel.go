@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationGetRequestModel struct {
+	Id string
+}
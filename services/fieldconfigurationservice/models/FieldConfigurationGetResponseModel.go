@@ -0,0 +1,9 @@
+package models
+
+type FieldConfigurationGetResponseModel struct {
+	Id          int64                         `json:"id"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description"`
+	IsDefault   bool                          `json:"isDefault"`
+	Items       []FieldConfigurationItemModel `json:"-"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type FieldConfigurationItemListResponseModel struct {
+	IsLast bool                          `json:"isLast"`
+	Values []FieldConfigurationItemModel `json:"values"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type FieldConfigurationItemModel struct {
+	Id          string `json:"id"`
+	Description string `json:"description"`
+	IsHidden    bool   `json:"isHidden"`
+	IsRequired  bool   `json:"isRequired"`
+	Renderer    string `json:"renderer,omitempty"`
+}
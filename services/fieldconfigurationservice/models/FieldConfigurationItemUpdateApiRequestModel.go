@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationItemUpdateApiRequestModel struct {
+	FieldConfigurationItems []FieldConfigurationItemModel `json:"fieldConfigurationItems"`
+}
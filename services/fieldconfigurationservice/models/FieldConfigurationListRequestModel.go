@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationListRequestModel struct {
+	Id string
+}
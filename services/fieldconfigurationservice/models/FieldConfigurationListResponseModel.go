@@ -0,0 +1,5 @@
+package models
+
+type FieldConfigurationListResponseModel struct {
+	Values []FieldConfigurationGetResponseModel `json:"values"`
+}
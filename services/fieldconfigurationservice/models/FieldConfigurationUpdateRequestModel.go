@@ -0,0 +1,9 @@
+package models
+
+type FieldConfigurationUpdateRequestModel struct {
+	Id            string                        `json:"-"`
+	Name          string                        `json:"name"`
+	Description   string                        `json:"description"`
+	Items         []FieldConfigurationItemModel `json:"-"`
+	RemovedFields []string                      `json:"-"`
+}
@@ -0,0 +1,261 @@
+package filterservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"sort"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/filterservice/models"
+)
+
+type IFilterService interface {
+	Get(ctx context.Context, model models.FilterGetRequestModel) (models.FilterGetResponseModel, error)
+	Create(ctx context.Context, model models.FilterCreateRequestModel) (models.FilterCreateResponseModel, error)
+	Update(ctx context.Context, model models.FilterUpdateRequestModel) (models.FilterUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.FilterDeleteRequestModel) (models.FilterDeleteResponseModel, error)
+	ValidateJql(ctx context.Context, model models.FilterValidateJqlRequestModel) (models.FilterValidateJqlResponseModel, error)
+}
+
+type FilterService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (f FilterService) Get(ctx context.Context, model models.FilterGetRequestModel) (models.FilterGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get filter w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, "/rest/api/2/filter/"+url2.PathEscape(model.Id)+"?expand=sharePermissions", nil)
+	if err != nil {
+		log.Println("failed to get filter")
+		return *new(models.FilterGetResponseModel), err
+	}
+
+	result := models.FilterGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FilterGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// jira reports project role shares as project shares carrying a role
+	result.Shares = []models.FilterShareModel{}
+	for _, permission := range result.SharePermissions {
+		share := models.FilterShareModel{
+			Id:   permission.Id,
+			Type: permission.Type,
+		}
+		if permission.Group != nil {
+			share.GroupName = permission.Group.Name
+		}
+		if permission.Project != nil {
+			share.ProjectId = permission.Project.Id
+		}
+		if permission.Role != nil {
+			share.Type = "projectRole"
+			share.ProjectRoleId = strconv.FormatInt(permission.Role.Id, 10)
+		}
+		result.Shares = append(result.Shares, share)
+	}
+	sort.Slice(result.Shares, func(a, b int) bool {
+		return shareKey(result.Shares[a]) < shareKey(result.Shares[b])
+	})
+
+	tflog.Info(ctx, "success get filter")
+	return result, nil
+}
+
+func (f FilterService) Create(ctx context.Context, model models.FilterCreateRequestModel) (models.FilterCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create filter w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/filter", model)
+	if err != nil {
+		log.Println("failed to create filter")
+		return *new(models.FilterCreateResponseModel), err
+	}
+
+	result := models.FilterCreateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.FilterCreateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	for _, share := range model.Shares {
+		err = f.addShare(ctx, result.Id, share)
+		if err != nil {
+			log.Println("failed to share created filter")
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create filter")
+	return result, nil
+}
+
+func (f FilterService) Update(ctx context.Context, model models.FilterUpdateRequestModel) (models.FilterUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update filter w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPut, "/rest/api/2/filter/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update filter")
+		return *new(models.FilterUpdateResponseModel), err
+	}
+
+	result := models.FilterUpdateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.FilterUpdateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	foundFilter, err := f.Get(ctx, models.FilterGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get filter for share update")
+		return *new(models.FilterUpdateResponseModel), err
+	}
+
+	wanted := map[string]bool{}
+	for _, share := range model.Shares {
+		wanted[shareKey(share)] = true
+	}
+	existing := map[string]bool{}
+	for _, share := range foundFilter.Shares {
+		existing[shareKey(share)] = true
+		if wanted[shareKey(share)] {
+			continue
+		}
+		err = f.removeShare(ctx, model.Id, share.Id)
+		if err != nil {
+			log.Println("failed to remove filter share")
+			return *new(models.FilterUpdateResponseModel), err
+		}
+	}
+	for _, share := range model.Shares {
+		if existing[shareKey(share)] {
+			continue
+		}
+		err = f.addShare(ctx, model.Id, share)
+		if err != nil {
+			log.Println("failed to add filter share")
+			return *new(models.FilterUpdateResponseModel), err
+		}
+	}
+
+	tflog.Info(ctx, "success update filter")
+	return result, nil
+}
+
+func (f FilterService) Delete(ctx context.Context, model models.FilterDeleteRequestModel) (models.FilterDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete filter w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodDelete, "/rest/api/2/filter/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete filter")
+		return *new(models.FilterDeleteResponseModel), err
+	}
+
+	log.Println("delete filter success")
+	return models.FilterDeleteResponseModel{}, nil
+}
+
+func (f FilterService) ValidateJql(ctx context.Context, model models.FilterValidateJqlRequestModel) (models.FilterValidateJqlResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start validate jql w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/jql/parse?validation=strict", models.FilterJqlParseApiRequestModel{
+		Queries: []string{model.Jql},
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return f.validateJqlWithSearch(ctx, model)
+	}
+	if err != nil {
+		log.Println("failed to validate jql")
+		return *new(models.FilterValidateJqlResponseModel), err
+	}
+
+	parsed := models.FilterJqlParseApiResponseModel{}
+	err = json.Unmarshal(body, &parsed)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.FilterValidateJqlResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	result := models.FilterValidateJqlResponseModel{
+		Errors: []string{},
+	}
+	for _, query := range parsed.Queries {
+		result.Errors = append(result.Errors, query.Errors...)
+	}
+
+	tflog.Info(ctx, "success validate jql")
+	return result, nil
+}
+
+// validateJqlWithSearch runs a strict zero result search, jira server versions without jql/parse still validate queries this way.
+func (f FilterService) validateJqlWithSearch(ctx context.Context, model models.FilterValidateJqlRequestModel) (models.FilterValidateJqlResponseModel, error) {
+	result := models.FilterValidateJqlResponseModel{
+		Errors: []string{},
+	}
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodGet, "/rest/api/2/search?maxResults=0&validateQuery=strict&fields=id&jql="+url2.QueryEscape(model.Jql), nil)
+	responseError := &baseservice.ResponseError{}
+	if errors.As(err, &responseError) && responseError.StatusCode == http.StatusBadRequest {
+		searchErrors := models.FilterSearchErrorApiResponseModel{}
+		err = json.Unmarshal([]byte(responseError.Body), &searchErrors)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.FilterValidateJqlResponseModel), errors.New("error unmarshalling response body")
+		}
+		result.Errors = append(result.Errors, searchErrors.ErrorMessages...)
+		return result, nil
+	}
+	if err != nil {
+		log.Println("failed to validate jql")
+		return *new(models.FilterValidateJqlResponseModel), err
+	}
+
+	tflog.Info(ctx, "success validate jql")
+	return result, nil
+}
+
+func (f FilterService) addShare(ctx context.Context, filterId string, share models.FilterShareModel) error {
+	tflog.Info(ctx, fmt.Sprintf("start add share to filter %s w. data: %v", filterId, share))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodPost, "/rest/api/2/filter/"+url2.PathEscape(filterId)+"/permission", share)
+	if err != nil {
+		log.Println("failed to add filter share")
+		return err
+	}
+
+	tflog.Info(ctx, "success add filter share")
+	return nil
+}
+
+func (f FilterService) removeShare(ctx context.Context, filterId string, shareId int64) error {
+	tflog.Info(ctx, fmt.Sprintf("start remove share %d from filter %s", shareId, filterId))
+
+	_, err := baseservice.Send(ctx, f.JiraServerBase, http.MethodDelete, "/rest/api/2/filter/"+url2.PathEscape(filterId)+"/permission/"+strconv.FormatInt(shareId, 10), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to remove filter share")
+		return err
+	}
+
+	log.Println("remove filter share success")
+	return nil
+}
+
+func shareKey(share models.FilterShareModel) string {
+	return share.Type + "/" + share.GroupName + "/" + share.ProjectId + "/" + share.ProjectRoleId
+}
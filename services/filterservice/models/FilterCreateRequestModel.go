@@ -0,0 +1,9 @@
+package models
+
+type FilterCreateRequestModel struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Jql         string             `json:"jql"`
+	Favourite   bool               `json:"favourite"`
+	Shares      []FilterShareModel `json:"-"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type FilterDeleteRequestModel struct {
+	Id string
+}
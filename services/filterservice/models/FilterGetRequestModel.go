@@ -0,0 +1,5 @@
+package models
+
+type FilterGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,39 @@
+package models
+
+type FilterGetResponseModel struct {
+	Id               string                          `json:"id"`
+	Name             string                          `json:"name"`
+	Description      string                          `json:"description"`
+	Jql              string                          `json:"jql"`
+	Favourite        bool                            `json:"favourite"`
+	SharePermissions []FilterSharePermissionApiModel `json:"sharePermissions"`
+	Shares           []FilterShareModel              `json:"-"`
+}
+
+type FilterSharePermissionApiModel struct {
+	Id      int64                       `json:"id"`
+	Type    string                      `json:"type"`
+	Group   *FilterShareGroupApiModel   `json:"group,omitempty"`
+	Project *FilterShareProjectApiModel `json:"project,omitempty"`
+	Role    *FilterShareRoleApiModel    `json:"role,omitempty"`
+}
+
+type FilterShareGroupApiModel struct {
+	Name string `json:"name"`
+}
+
+type FilterShareProjectApiModel struct {
+	Id string `json:"id"`
+}
+
+type FilterShareRoleApiModel struct {
+	Id int64 `json:"id"`
+}
+
+type FilterShareModel struct {
+	Id            int64  `json:"-"`
+	Type          string `json:"type"`
+	GroupName     string `json:"groupname,omitempty"`
+	ProjectId     string `json:"projectId,omitempty"`
+	ProjectRoleId string `json:"projectRoleId,omitempty"`
+}
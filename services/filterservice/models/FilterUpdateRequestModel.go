@@ -0,0 +1,10 @@
+package models
+
+type FilterUpdateRequestModel struct {
+	Id          string             `json:"-"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Jql         string             `json:"jql"`
+	Favourite   bool               `json:"favourite"`
+	Shares      []FilterShareModel `json:"-"`
+}
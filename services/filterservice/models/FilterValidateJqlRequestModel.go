@@ -0,0 +1,18 @@
+package models
+
+type FilterValidateJqlRequestModel struct {
+	Jql string
+}
+
+type FilterJqlParseApiRequestModel struct {
+	Queries []string `json:"queries"`
+}
+
+type FilterJqlParseApiResponseModel struct {
+	Queries []FilterJqlParsedQueryApiModel `json:"queries"`
+}
+
+type FilterJqlParsedQueryApiModel struct {
+	Query  string   `json:"query"`
+	Errors []string `json:"errors"`
+}
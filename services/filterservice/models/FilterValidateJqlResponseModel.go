@@ -0,0 +1,9 @@
+package models
+
+type FilterValidateJqlResponseModel struct {
+	Errors []string
+}
+
+type FilterSearchErrorApiResponseModel struct {
+	ErrorMessages []string `json:"errorMessages"`
+}
@@ -0,0 +1,143 @@
+package globalpermissionservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"html"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"regexp"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/globalpermissionservice/models"
+)
+
+// jira server has no rest api for global permission holders, the admin page lists each grant with a delete link
+var grantLinkPattern = regexp.MustCompile(`GlobalPermissions!del\.jspa\?globalPermType=([A-Za-z_]+)&(?:amp;)?groupName=([^"&]*)`)
+
+type IGlobalPermissionService interface {
+	List(ctx context.Context, model models.GlobalPermissionListRequestModel) (models.GlobalPermissionListResponseModel, error)
+	Get(ctx context.Context, model models.GlobalPermissionGetRequestModel) (models.GlobalPermissionGetResponseModel, error)
+	Create(ctx context.Context, model models.GlobalPermissionCreateRequestModel) (models.GlobalPermissionCreateResponseModel, error)
+	Delete(ctx context.Context, model models.GlobalPermissionDeleteRequestModel) (models.GlobalPermissionDeleteResponseModel, error)
+}
+
+type GlobalPermissionService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (g GlobalPermissionService) List(ctx context.Context, model models.GlobalPermissionListRequestModel) (models.GlobalPermissionListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list global permissions w. data: %v", model))
+
+	body, err := baseservice.SendForm(ctx, g.JiraServerBase, http.MethodGet, "/secure/admin/GlobalPermissions!default.jspa", nil)
+	if err != nil {
+		log.Println("failed to read global permissions page")
+		return *new(models.GlobalPermissionListResponseModel), err
+	}
+	if !strings.Contains(string(body), "globalPermType") {
+		return *new(models.GlobalPermissionListResponseModel), errors.New("failed to read global permissions page, the token needs jira system administrator rights")
+	}
+
+	result := models.GlobalPermissionListResponseModel{}
+	for _, match := range grantLinkPattern.FindAllStringSubmatch(string(body), -1) {
+		groupName, err := url2.QueryUnescape(html.UnescapeString(match[2]))
+		if err != nil {
+			groupName = html.UnescapeString(match[2])
+		}
+		result = append(result, models.GlobalPermissionGetResponseModel{
+			Permission: match[1],
+			GroupName:  groupName,
+		})
+	}
+
+	tflog.Info(ctx, "success list global permissions")
+	return result, nil
+}
+
+func (g GlobalPermissionService) Get(ctx context.Context, model models.GlobalPermissionGetRequestModel) (models.GlobalPermissionGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get global permission w. data: %v", model))
+
+	permissions, err := g.List(ctx, models.GlobalPermissionListRequestModel{})
+	if err != nil {
+		log.Println("failed to list global permissions")
+		return *new(models.GlobalPermissionGetResponseModel), err
+	}
+
+	for _, permission := range permissions {
+		if permission.Permission == model.Permission && permission.GroupName == model.GroupName {
+			tflog.Info(ctx, "success get global permission")
+			return permission, nil
+		}
+	}
+
+	tflog.Info(ctx, "global permission not found")
+	return *new(models.GlobalPermissionGetResponseModel), nil
+}
+
+func (g GlobalPermissionService) Create(ctx context.Context, model models.GlobalPermissionCreateRequestModel) (models.GlobalPermissionCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create global permission w. data: %v", model))
+
+	form := url2.Values{}
+	form.Set("action", "add")
+	form.Set("globalPermType", model.Permission)
+	form.Set("groupName", model.GroupName)
+
+	_, err := baseservice.SendForm(ctx, g.JiraServerBase, http.MethodPost, "/secure/admin/GlobalPermissions.jspa", form)
+	if err != nil {
+		log.Println("failed to add global permission")
+		return *new(models.GlobalPermissionCreateResponseModel), err
+	}
+
+	// the admin action answers with an html page either way, check the grant actually exists
+	foundPermission, err := g.Get(ctx, models.GlobalPermissionGetRequestModel{
+		Permission: model.Permission,
+		GroupName:  model.GroupName,
+	})
+	if err != nil {
+		log.Println("failed to get created global permission")
+		return *new(models.GlobalPermissionCreateResponseModel), err
+	}
+	if foundPermission.Permission == "" {
+		return *new(models.GlobalPermissionCreateResponseModel), errors.New("jira did not grant " + model.Permission + " to group " + model.GroupName + ", check that the group exists")
+	}
+
+	tflog.Info(ctx, "success create global permission")
+	return models.GlobalPermissionCreateResponseModel{
+		Permission: foundPermission.Permission,
+		GroupName:  foundPermission.GroupName,
+	}, nil
+}
+
+func (g GlobalPermissionService) Delete(ctx context.Context, model models.GlobalPermissionDeleteRequestModel) (models.GlobalPermissionDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete global permission w. data: %v", model))
+
+	form := url2.Values{}
+	form.Set("globalPermType", model.Permission)
+	form.Set("groupName", model.GroupName)
+	form.Set("confirm", "true")
+
+	_, err := baseservice.SendForm(ctx, g.JiraServerBase, http.MethodPost, "/secure/admin/GlobalPermissions!del.jspa", form)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete global permission")
+		return *new(models.GlobalPermissionDeleteResponseModel), err
+	}
+
+	foundPermission, err := g.Get(ctx, models.GlobalPermissionGetRequestModel{
+		Permission: model.Permission,
+		GroupName:  model.GroupName,
+	})
+	if err != nil {
+		log.Println("failed to get deleted global permission")
+		return *new(models.GlobalPermissionDeleteResponseModel), err
+	}
+	if foundPermission.Permission != "" {
+		return *new(models.GlobalPermissionDeleteResponseModel), errors.New("jira did not remove " + model.Permission + " from group " + model.GroupName + ", jira refuses to remove the last administrator group")
+	}
+
+	log.Println("delete global permission success")
+	return models.GlobalPermissionDeleteResponseModel{}, nil
+}
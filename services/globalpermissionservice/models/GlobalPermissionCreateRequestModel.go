@@ -0,0 +1,6 @@
+package models
+
+type GlobalPermissionCreateRequestModel struct {
+	Permission string
+	GroupName  string
+}
@@ -0,0 +1,6 @@
+package models
+
+type GlobalPermissionCreateResponseModel struct {
+	Permission string
+	GroupName  string
+}
@@ -0,0 +1,6 @@
+package models
+
+type GlobalPermissionDeleteRequestModel struct {
+	Permission string
+	GroupName  string
+}
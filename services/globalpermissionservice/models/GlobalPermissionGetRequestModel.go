@@ -0,0 +1,6 @@
+package models
+
+type GlobalPermissionGetRequestModel struct {
+	Permission string
+	GroupName  string
+}
@@ -0,0 +1,6 @@
+package models
+
+type GlobalPermissionGetResponseModel struct {
+	Permission string
+	GroupName  string
+}
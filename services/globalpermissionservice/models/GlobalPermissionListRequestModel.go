@@ -0,0 +1,4 @@
+package models
+
+type GlobalPermissionListRequestModel struct {
+}
@@ -12,15 +12,16 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice"
 	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
 	"terraform-provider-hashicups-pf/services/grantservice/models"
 	"terraform-provider-hashicups-pf/services/permissionschemeservice"
 	models3 "terraform-provider-hashicups-pf/services/permissionschemeservice/models"
-	"terraform-provider-hashicups-pf/services/projectroleservice"
-	models4 "terraform-provider-hashicups-pf/services/projectroleservice/models"
 	"time"
 )
 
+var ErrGrantNotFound = errors.New("permission scheme grant not found")
+
 type IGrantService interface {
 	Get(ctx context.Context, model models.GrantGetRequestModel) (models.GrantGetResponseModel, error)
 	List(ctx context.Context, model models.GrantListRequestModel) (models.GrantListResponseModel, error)
@@ -33,7 +34,11 @@ type GrantService struct {
 }
 
 func (g GrantService) Get(ctx context.Context, model models.GrantGetRequestModel) (models.GrantGetResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list permission scheme grants w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start get permission scheme grant w. data: %v", model))
+
+	if model.Id != 0 {
+		return g.getById(ctx, model.PermissionSchemeId, model.Id)
+	}
 
 	grantsResult, err := g.List(ctx, models.GrantListRequestModel{
 		PermissionSchemeId: model.PermissionSchemeId,
@@ -43,84 +48,49 @@ func (g GrantService) Get(ctx context.Context, model models.GrantGetRequestModel
 		return *new(models.GrantGetResponseModel), errors.New("failed to find permission scheme")
 	}
 
-	projectRoleService := projectroleservice.ProjectRoleService{
-		JiraServerBase: g.JiraServerBase,
-	}
-
-	param, _ := strconv.Atoi(model.Holder.Parameter)
-	projectRoleFound, err := projectRoleService.GetRole(ctx, models4.ProjectRoleGetRequestModel{
-		Id: int64(param),
-	})
-	if err != nil {
-		tflog.Info(ctx, "failed to find project role with role name "+model.Holder.Parameter)
-		return *new(models.GrantGetResponseModel), errors.New("failed to find project role with role name " + model.Holder.Parameter)
-	}
-
 	foundGrant := models.GrantGetResponseModel{}
 	for _, grant := range grantsResult.Grants {
-		if grant.Permission == model.Permission && strings.ToLower(grant.Holder.Type) == strings.ToLower(model.Holder.Type) && grant.Holder.Parameter == strconv.FormatInt(projectRoleFound.Id, 10) {
+		if grant.Permission == model.Permission && strings.ToLower(grant.Holder.Type) == strings.ToLower(model.Holder.Type) && grant.Holder.Parameter == model.Holder.Parameter {
 			log.Println(grant)
 			foundGrant = grant
 		}
 	}
 	if foundGrant.Permission == "" {
 		tflog.Info(ctx, "failed to get permission scheme grant")
-		return *new(models.GrantGetResponseModel), errors.New("failed to find permission scheme grant in returned grants list")
+		return *new(models.GrantGetResponseModel), ErrGrantNotFound
 	}
 
-	foundGrant.Holder.Parameter = model.Holder.Parameter
-	foundGrant.PermissionSchemeId = model.PermissionSchemeId
 	tflog.Info(ctx, "success find permission scheme grant")
 	return foundGrant, nil
 }
 
 func (g GrantService) List(ctx context.Context, model models.GrantListRequestModel) (models.GrantListResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list permission scheme grants w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start list permission scheme grants w. data: %v", model))
 
 	permissionSchemeService := permissionschemeservice.PermissionSchemeService{
 		JiraServerBase: g.JiraServerBase,
 	}
 
+	// the expanded scheme already holds every grant, no separate permission call needed
 	permissionSchemeFound, err := permissionSchemeService.Get(ctx, models3.PermissionSchemeGetRequestModel{
 		Id: model.PermissionSchemeId,
 	})
 	if err != nil {
-		tflog.Info(ctx, "failed to find permission scheme w. name "+permissionSchemeFound.Name)
-		return *new(models.GrantListResponseModel), errors.New("failed to find permission scheme")
-	}
-
-	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(permissionSchemeFound.Id, 10) + "/permission"
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		log.Println("error building http request")
-		return *new(models.GrantListResponseModel), errors.New("error building http request")
-	}
-	req.Header.Set("Authorization", g.JiraServerBase.AuthorizationMethod+" "+g.JiraServerBase.Token)
-	req.Header.Set("Accept", "application/json")
-	client := http.Client{
-		Timeout: time.Second * 30,
-	}
-	res, err := client.Do(req)
-	if err != nil {
-		log.Println("error result from http request")
-		return *new(models.GrantListResponseModel), errors.New("error result from http request")
+		tflog.Info(ctx, "failed to find permission scheme w. id "+strconv.FormatInt(model.PermissionSchemeId, 10))
+		return *new(models.GrantListResponseModel), err
 	}
-	defer res.Body.Close()
-
-	log.Println(res.Status)
-	body, err := io.ReadAll(res.Body)
-	if err != nil {
-		log.Println("read all body failed")
-		return *new(models.GrantListResponseModel), errors.New("error reading response body")
-	}
-
-	log.Println("response body: " + string(body))
 
 	result := models.GrantListResponseModel{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		log.Println("failed to unmarshal response body")
-		return *new(models.GrantListResponseModel), errors.New("error unmarshalling response body")
+	for _, permission := range permissionSchemeFound.Permissions {
+		result.Grants = append(result.Grants, models.GrantGetResponseModel{
+			Id:                 permission.Id,
+			PermissionSchemeId: permissionSchemeFound.Id,
+			Permission:         permission.Permission,
+			Holder: models.GrantHolderModel{
+				Type:      permission.Holder.Type,
+				Parameter: permission.Holder.Parameter,
+			},
+		})
 	}
 
 	log.Println("success list permission scheme grants")
@@ -128,39 +98,12 @@ func (g GrantService) List(ctx context.Context, model models.GrantListRequestMod
 }
 
 func (g GrantService) Create(ctx context.Context, model models.GrantCreateRequestModel) (models.GrantCreateResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start create permission scheme grant w. data: %s", model))
-
-	permissionSchemeService := permissionschemeservice.PermissionSchemeService{
-		JiraServerBase: g.JiraServerBase,
-	}
-
-	projectRoleService := projectroleservice.ProjectRoleService{
-		JiraServerBase: g.JiraServerBase,
-	}
-
-	projectRoleFound, err := projectRoleService.GetRole(ctx, models4.ProjectRoleGetRequestModel{
-		Id: model.Holder.Parameter,
-	})
-	if err != nil {
-		tflog.Info(ctx, "failed to find project role with role name "+strconv.Itoa(int(model.Holder.Parameter)))
-		return *new(models.GrantCreateResponseModel), errors.New("failed to find project role")
-	}
+	tflog.Info(ctx, fmt.Sprintf("start create permission scheme grant w. data: %v", model))
 
-	permissionSchemeFound, err := permissionSchemeService.Get(ctx, models3.PermissionSchemeGetRequestModel{
-		Id: model.PermissionSchemeId,
-	})
-	if err != nil {
-		tflog.Info(ctx, "failed to find permission scheme w. name "+permissionSchemeFound.Name)
-		return *new(models.GrantCreateResponseModel), errors.New("failed to find permission scheme")
-	}
-
-	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(permissionSchemeFound.Id, 10) + "/permission"
+	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(model.PermissionSchemeId, 10) + "/permission"
 	serial, err := json.Marshal(models.GrantCreateApiRequestModel{
 		Permission: model.Permission,
-		Holder: models.GrantApiHolderModel(struct {
-			Type      string
-			Parameter int64
-		}{Type: model.Holder.Type, Parameter: projectRoleFound.Id}),
+		Holder:     model.Holder,
 	})
 	if err != nil {
 		tflog.Info(ctx, "failed to marshal body request")
@@ -193,6 +136,9 @@ func (g GrantService) Create(ctx context.Context, model models.GrantCreateReques
 	}
 
 	log.Println("response body: " + string(body))
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return *new(models.GrantCreateResponseModel), errors.New("create permission scheme grant returned " + res.Status + ": " + string(body))
+	}
 
 	result := models.GrantCreateResponseModel{}
 	err = json.Unmarshal(body, &result)
@@ -208,50 +154,84 @@ func (g GrantService) Create(ctx context.Context, model models.GrantCreateReques
 }
 
 func (g GrantService) Delete(ctx context.Context, model models.GrantDeleteRequestModel) (models.GrantDeleteResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list permission scheme grants w. data: %s", model))
-
-	permissionSchemeService := permissionschemeservice.PermissionSchemeService{
-		JiraServerBase: g.JiraServerBase,
+	tflog.Info(ctx, fmt.Sprintf("start delete permission scheme grant w. data: %v", model))
+
+	// grants are looked up by permission and holder only when the id is not known yet
+	grantId := model.Id
+	if grantId == 0 {
+		foundGrant, err := g.Get(ctx, models.GrantGetRequestModel{
+			Permission:         model.Permission,
+			Holder:             model.Holder,
+			PermissionSchemeId: model.PermissionSchemeId,
+		})
+		if errors.Is(err, ErrGrantNotFound) {
+			log.Println("grant already deleted")
+			return models.GrantDeleteResponseModel{}, nil
+		}
+		if err != nil {
+			log.Println("failed to find grant")
+			return *new(models.GrantDeleteResponseModel), errors.New("failed to find perm. scheme grant")
+		}
+		grantId = foundGrant.Id
 	}
 
-	permissionSchemeFound, err := permissionSchemeService.Get(ctx, models3.PermissionSchemeGetRequestModel{
-		Id: model.PermissionSchemeId,
-	})
-	if err != nil {
-		tflog.Info(ctx, "failed to find permission scheme w. name "+permissionSchemeFound.Name)
-		return *new(models.GrantDeleteResponseModel), errors.New("failed to find permission scheme")
+	_, err := baseservice.Send(ctx, g.JiraServerBase, http.MethodDelete, "/rest/api/2/permissionscheme/"+strconv.FormatInt(model.PermissionSchemeId, 10)+"/permission/"+strconv.FormatInt(grantId, 10), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("grant already deleted")
+		return models.GrantDeleteResponseModel{}, nil
 	}
-
-	foundGrant, err := g.Get(ctx, models.GrantGetRequestModel{
-		Permission:         model.Permission,
-		Holder:             model.Holder,
-		PermissionSchemeId: model.PermissionSchemeId,
-	})
 	if err != nil {
-		log.Println("failed to find grant")
-		return *new(models.GrantDeleteResponseModel), errors.New("failed to find perm. scheme grant")
+		log.Println("failed to delete permission scheme grant")
+		return *new(models.GrantDeleteResponseModel), err
 	}
 
-	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(permissionSchemeFound.Id, 10) + "/permission/" + strconv.FormatInt(foundGrant.Id, 10)
-	req, err := http.NewRequest(http.MethodDelete, url, nil)
+	log.Println("delete permission scheme grant success")
+	return models.GrantDeleteResponseModel{}, nil
+}
+
+func (g GrantService) getById(ctx context.Context, permissionSchemeId int64, grantId int64) (models.GrantGetResponseModel, error) {
+	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(permissionSchemeId, 10) + "/permission/" + strconv.FormatInt(grantId, 10)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		log.Println("error building http request")
-		return *new(models.GrantDeleteResponseModel), errors.New("error building http request")
+		tflog.Info(ctx, "error building http request")
+		return *new(models.GrantGetResponseModel), errors.New("error building http request")
 	}
 	req.Header.Set("Authorization", g.JiraServerBase.AuthorizationMethod+" "+g.JiraServerBase.Token)
+	req.Header.Set("Accept", "application/json")
 
 	client := http.Client{
 		Timeout: time.Second * 30,
 	}
 	res, err := client.Do(req)
 	if err != nil {
-		log.Println("error result from http request")
-		return *new(models.GrantDeleteResponseModel), errors.New("error result from http request")
+		tflog.Info(ctx, "error result from http request")
+		return *new(models.GrantGetResponseModel), errors.New("error result from http request " + err.Error())
 	}
 	defer res.Body.Close()
 
-	log.Println(res.Status)
+	tflog.Info(ctx, res.Status)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		tflog.Info(ctx, "read all body failed")
+		return *new(models.GrantGetResponseModel), errors.New("error reading response body")
+	}
 
-	log.Println("delete permission scheme grant success")
-	return models.GrantDeleteResponseModel{}, nil
+	tflog.Info(ctx, "response body: "+string(body))
+	if res.StatusCode == http.StatusNotFound {
+		return *new(models.GrantGetResponseModel), ErrGrantNotFound
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return *new(models.GrantGetResponseModel), errors.New("get permission scheme grant returned " + res.Status + ": " + string(body))
+	}
+
+	result := models.GrantGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.GrantGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	result.PermissionSchemeId = permissionSchemeId
+	tflog.Info(ctx, "success get permission scheme grant")
+	return result, nil
 }
@@ -1,6 +1,7 @@
 package models
 
 type GrantGetRequestModel struct {
+	Id                 int64            `json:"id"`
 	PermissionSchemeId int64            `json:"permissionSchemeId"`
 	Permission         string           `json:"permission"`
 	Holder             GrantHolderModel `json:"holder"`
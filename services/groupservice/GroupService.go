@@ -28,7 +28,7 @@ type GroupService struct {
 }
 
 func (g GroupService) Create(ctx context.Context, model models.GroupCreateRequestModel) (models.GroupCreateResponseModel, error) {
-	log.Printf("start create group w. data: %s", model)
+	log.Printf("start create group w. data: %v", model)
 	serialized, err := json.Marshal(model)
 	if err != nil {
 		log.Println("failed to marshal create request body")
@@ -78,7 +78,7 @@ func (g GroupService) Create(ctx context.Context, model models.GroupCreateReques
 }
 
 func (g GroupService) Get(ctx context.Context, model models.GroupGetRequestModel) (models.GroupGetResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start get group w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start get group w. data: %v", model))
 	grouplist, err := g.List(ctx, models.GroupListApiRequestModel{
 		GroupName: model.Name,
 	})
@@ -100,7 +100,7 @@ func (g GroupService) Get(ctx context.Context, model models.GroupGetRequestModel
 }
 
 func (g GroupService) List(ctx context.Context, model models.GroupListApiRequestModel) (models.GroupListResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list groups w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start list groups w. data: %v", model))
 	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/groups/picker?query=" + url2.QueryEscape(model.GroupName)
 	log.Println(url)
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -142,7 +142,7 @@ func (g GroupService) List(ctx context.Context, model models.GroupListApiRequest
 }
 
 func (g GroupService) Delete(ctx context.Context, model models.GroupDeleteRequestModel) (models.GroupDeleteResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start delete group w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start delete group w. data: %v", model))
 	url := "https://" + g.JiraServerBase.Domain + "/rest/api/2/group?groupname=" + model.Name
 	req, err := http.NewRequest(http.MethodDelete, url, nil)
 	if err != nil {
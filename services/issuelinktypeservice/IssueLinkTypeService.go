@@ -0,0 +1,124 @@
+package issuelinktypeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuelinktypeservice/models"
+)
+
+type IIssueLinkTypeService interface {
+	List(ctx context.Context, model models.IssueLinkTypeListRequestModel) (models.IssueLinkTypeListResponseModel, error)
+	Get(ctx context.Context, model models.IssueLinkTypeGetRequestModel) (models.IssueLinkTypeGetResponseModel, error)
+	Create(ctx context.Context, model models.IssueLinkTypeCreateRequestModel) (models.IssueLinkTypeCreateResponseModel, error)
+	Update(ctx context.Context, model models.IssueLinkTypeUpdateRequestModel) (models.IssueLinkTypeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.IssueLinkTypeDeleteRequestModel) (models.IssueLinkTypeDeleteResponseModel, error)
+}
+
+type IssueLinkTypeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (i IssueLinkTypeService) List(ctx context.Context, model models.IssueLinkTypeListRequestModel) (models.IssueLinkTypeListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list issue link types w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodGet, "/rest/api/2/issueLinkType", nil)
+	if err != nil {
+		log.Println("failed to list issue link types")
+		return *new(models.IssueLinkTypeListResponseModel), err
+	}
+
+	result := models.IssueLinkTypeListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueLinkTypeListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list issue link types")
+	return result, nil
+}
+
+func (i IssueLinkTypeService) Get(ctx context.Context, model models.IssueLinkTypeGetRequestModel) (models.IssueLinkTypeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get issue link type w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodGet, "/rest/api/2/issueLinkType/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get issue link type")
+		return *new(models.IssueLinkTypeGetResponseModel), err
+	}
+
+	result := models.IssueLinkTypeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueLinkTypeGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get issue link type")
+	return result, nil
+}
+
+func (i IssueLinkTypeService) Create(ctx context.Context, model models.IssueLinkTypeCreateRequestModel) (models.IssueLinkTypeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create issue link type w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodPost, "/rest/api/2/issueLinkType", model)
+	if err != nil {
+		log.Println("failed to create issue link type")
+		return *new(models.IssueLinkTypeCreateResponseModel), err
+	}
+
+	result := models.IssueLinkTypeCreateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.IssueLinkTypeCreateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success create issue link type")
+	return result, nil
+}
+
+func (i IssueLinkTypeService) Update(ctx context.Context, model models.IssueLinkTypeUpdateRequestModel) (models.IssueLinkTypeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update issue link type w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodPut, "/rest/api/2/issueLinkType/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update issue link type")
+		return *new(models.IssueLinkTypeUpdateResponseModel), err
+	}
+
+	result := models.IssueLinkTypeUpdateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.IssueLinkTypeUpdateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success update issue link type")
+	return result, nil
+}
+
+func (i IssueLinkTypeService) Delete(ctx context.Context, model models.IssueLinkTypeDeleteRequestModel) (models.IssueLinkTypeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete issue link type w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodDelete, "/rest/api/2/issueLinkType/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete issue link type")
+		return *new(models.IssueLinkTypeDeleteResponseModel), err
+	}
+
+	log.Println("delete issue link type success")
+	return models.IssueLinkTypeDeleteResponseModel{}, nil
+}
@@ -0,0 +1,7 @@
+package models
+
+type IssueLinkTypeCreateRequestModel struct {
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
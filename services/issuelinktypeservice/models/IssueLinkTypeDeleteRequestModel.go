@@ -0,0 +1,5 @@
+package models
+
+type IssueLinkTypeDeleteRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueLinkTypeGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueLinkTypeListResponseModel struct {
+	IssueLinkTypes []IssueLinkTypeGetResponseModel `json:"issueLinkTypes"`
+}
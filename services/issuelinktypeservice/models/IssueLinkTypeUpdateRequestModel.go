@@ -0,0 +1,8 @@
+package models
+
+type IssueLinkTypeUpdateRequestModel struct {
+	Id      string `json:"-"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type IssueLinkTypeUpdateResponseModel struct {
+	Id      string `json:"id"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward"`
+	Outward string `json:"outward"`
+}
@@ -0,0 +1,314 @@
+package issuesecurityschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"sort"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuesecurityschemeservice/models"
+)
+
+type IIssueSecuritySchemeService interface {
+	List(ctx context.Context, model models.IssueSecuritySchemeListRequestModel) (models.IssueSecuritySchemeListResponseModel, error)
+	Get(ctx context.Context, model models.IssueSecuritySchemeGetRequestModel) (models.IssueSecuritySchemeGetResponseModel, error)
+	Create(ctx context.Context, model models.IssueSecuritySchemeCreateRequestModel) (models.IssueSecuritySchemeCreateResponseModel, error)
+	Update(ctx context.Context, model models.IssueSecuritySchemeUpdateRequestModel) (models.IssueSecuritySchemeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.IssueSecuritySchemeDeleteRequestModel) (models.IssueSecuritySchemeDeleteResponseModel, error)
+}
+
+type IssueSecuritySchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s IssueSecuritySchemeService) List(ctx context.Context, model models.IssueSecuritySchemeListRequestModel) (models.IssueSecuritySchemeListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list issue security schemes w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/issuesecurityschemes", nil)
+	if err != nil {
+		log.Println("failed to list issue security schemes")
+		return *new(models.IssueSecuritySchemeListResponseModel), err
+	}
+
+	result := models.IssueSecuritySchemeListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueSecuritySchemeListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list issue security schemes")
+	return result, nil
+}
+
+func (s IssueSecuritySchemeService) Get(ctx context.Context, model models.IssueSecuritySchemeGetRequestModel) (models.IssueSecuritySchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get issue security scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/issuesecurityschemes/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "issue security scheme not found")
+		return *new(models.IssueSecuritySchemeGetResponseModel), errors.New("failed to find issue security scheme " + model.Id)
+	}
+	if err != nil {
+		log.Println("failed to get issue security scheme")
+		return *new(models.IssueSecuritySchemeGetResponseModel), err
+	}
+
+	result := models.IssueSecuritySchemeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueSecuritySchemeGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	members, err := s.listMembers(ctx, model.Id)
+	if err != nil {
+		log.Println("failed to list issue security level members")
+		return *new(models.IssueSecuritySchemeGetResponseModel), err
+	}
+
+	// members come back paged across all levels, attach them to their level in a stable order
+	for index := range result.Levels {
+		level := &result.Levels[index]
+		level.IsDefault = level.Id == strconv.FormatInt(result.DefaultSecurityLevelId, 10)
+		level.Members = []models.IssueSecurityHolderModel{}
+		for _, member := range members {
+			if member.IssueSecurityLevelId == level.Id {
+				level.Members = append(level.Members, member.Holder)
+			}
+		}
+		sort.Slice(level.Members, func(a, b int) bool {
+			return holderKey(level.Members[a]) < holderKey(level.Members[b])
+		})
+	}
+
+	tflog.Info(ctx, "success get issue security scheme")
+	return result, nil
+}
+
+func (s IssueSecuritySchemeService) Create(ctx context.Context, model models.IssueSecuritySchemeCreateRequestModel) (models.IssueSecuritySchemeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create issue security scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPost, "/rest/api/2/issuesecurityschemes", model)
+	if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+		// jira server versions without scheme management answer the write endpoint with 404 or 405
+		return *new(models.IssueSecuritySchemeCreateResponseModel), fmt.Errorf("creating issue security schemes needs POST /rest/api/2/issuesecurityschemes: %w", baseservice.ErrNotSupported)
+	}
+	if err != nil {
+		log.Println("failed to create issue security scheme")
+		return *new(models.IssueSecuritySchemeCreateResponseModel), err
+	}
+
+	result := models.IssueSecuritySchemeCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueSecuritySchemeCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create issue security scheme")
+	return result, nil
+}
+
+func (s IssueSecuritySchemeService) Update(ctx context.Context, model models.IssueSecuritySchemeUpdateRequestModel) (models.IssueSecuritySchemeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update issue security scheme w. data: %v", model))
+
+	schemePath := "/rest/api/2/issuesecurityschemes/" + url2.PathEscape(model.Id)
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, schemePath, model)
+	if err != nil {
+		log.Println("failed to update issue security scheme")
+		return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+	}
+
+	foundScheme, err := s.Get(ctx, models.IssueSecuritySchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get issue security scheme for update")
+		return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+	}
+
+	members, err := s.listMembers(ctx, model.Id)
+	if err != nil {
+		log.Println("failed to list issue security level members for update")
+		return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+	}
+
+	// levels are matched by name, jira assigns the ids
+	existingLevels := map[string]models.IssueSecurityLevelModel{}
+	for _, level := range foundScheme.Levels {
+		existingLevels[level.Name] = level
+	}
+	wantedLevels := map[string]bool{}
+	addedLevels := []models.IssueSecurityLevelModel{}
+	for _, level := range model.Levels {
+		wantedLevels[level.Name] = true
+		existingLevel, ok := existingLevels[level.Name]
+		if !ok {
+			addedLevels = append(addedLevels, level)
+			continue
+		}
+
+		levelPath := schemePath + "/level/" + url2.PathEscape(existingLevel.Id)
+		if existingLevel.Description != level.Description {
+			_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, levelPath, models.IssueSecurityLevelModel{
+				Name:        level.Name,
+				Description: level.Description,
+			})
+			if err != nil {
+				log.Println("failed to update issue security level")
+				return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+			}
+		}
+
+		wantedMembers := map[string]bool{}
+		for _, member := range level.Members {
+			wantedMembers[holderKey(member)] = true
+		}
+		existingMembers := map[string]bool{}
+		for _, member := range members {
+			if member.IssueSecurityLevelId != existingLevel.Id {
+				continue
+			}
+			existingMembers[holderKey(member.Holder)] = true
+			if wantedMembers[holderKey(member.Holder)] {
+				continue
+			}
+			_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, levelPath+"/member/"+url2.PathEscape(member.Id), nil)
+			if err != nil {
+				log.Println("failed to remove issue security level member")
+				return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+			}
+		}
+		addedMembers := []models.IssueSecurityHolderModel{}
+		for _, member := range level.Members {
+			if !existingMembers[holderKey(member)] {
+				addedMembers = append(addedMembers, member)
+			}
+		}
+		if len(addedMembers) > 0 {
+			_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, levelPath+"/member", models.IssueSecurityMembersApiRequestModel{
+				Members: addedMembers,
+			})
+			if err != nil {
+				log.Println("failed to add issue security level members")
+				return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+			}
+		}
+	}
+
+	if len(addedLevels) > 0 {
+		_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, schemePath+"/level", models.IssueSecurityLevelsApiRequestModel{
+			Levels: addedLevels,
+		})
+		if err != nil {
+			log.Println("failed to add issue security levels")
+			return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+		}
+	}
+
+	// move the default away first, jira refuses to delete the default level
+	err = s.setDefaultLevel(ctx, model)
+	if err != nil {
+		log.Println("failed to set default issue security level")
+		return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+	}
+
+	for _, level := range foundScheme.Levels {
+		if wantedLevels[level.Name] {
+			continue
+		}
+		_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, schemePath+"/level/"+url2.PathEscape(level.Id), nil)
+		if err != nil {
+			log.Println("failed to remove issue security level")
+			return *new(models.IssueSecuritySchemeUpdateResponseModel), err
+		}
+	}
+
+	tflog.Info(ctx, "success update issue security scheme")
+	return models.IssueSecuritySchemeUpdateResponseModel{}, nil
+}
+
+func (s IssueSecuritySchemeService) Delete(ctx context.Context, model models.IssueSecuritySchemeDeleteRequestModel) (models.IssueSecuritySchemeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete issue security scheme w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, "/rest/api/2/issuesecurityschemes/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to delete issue security scheme")
+		return *new(models.IssueSecuritySchemeDeleteResponseModel), err
+	}
+
+	log.Println("delete issue security scheme success")
+	return models.IssueSecuritySchemeDeleteResponseModel{}, nil
+}
+
+func (s IssueSecuritySchemeService) setDefaultLevel(ctx context.Context, model models.IssueSecuritySchemeUpdateRequestModel) error {
+	defaultLevelName := ""
+	for _, level := range model.Levels {
+		if level.IsDefault {
+			defaultLevelName = level.Name
+		}
+	}
+
+	foundScheme, err := s.Get(ctx, models.IssueSecuritySchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		return err
+	}
+
+	defaultLevelId := "-1"
+	for _, level := range foundScheme.Levels {
+		if level.Name == defaultLevelName {
+			defaultLevelId = level.Id
+		}
+	}
+	if defaultLevelId == strconv.FormatInt(foundScheme.DefaultSecurityLevelId, 10) {
+		return nil
+	}
+
+	_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/issuesecurityschemes/level/default", models.IssueSecurityDefaultLevelsApiRequestModel{
+		DefaultValues: []models.IssueSecurityDefaultLevelApiModel{
+			{
+				IssueSecuritySchemeId: model.Id,
+				DefaultLevelId:        defaultLevelId,
+			},
+		},
+	})
+	return err
+}
+
+func (s IssueSecuritySchemeService) listMembers(ctx context.Context, schemeId string) ([]models.IssueSecurityMemberModel, error) {
+	members := []models.IssueSecurityMemberModel{}
+	startAt := int64(0)
+	for {
+		body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/issuesecurityschemes/level/member?issueSecuritySchemeId="+url2.QueryEscape(schemeId)+"&startAt="+strconv.FormatInt(startAt, 10), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		page := models.IssueSecurityMemberListResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return nil, errors.New("error unmarshalling response body")
+		}
+
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return members, nil
+		}
+		startAt += int64(len(page.Values))
+	}
+}
+
+func holderKey(holder models.IssueSecurityHolderModel) string {
+	return holder.Type + "/" + holder.Parameter
+}
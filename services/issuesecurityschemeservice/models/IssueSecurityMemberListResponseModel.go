@@ -0,0 +1,14 @@
+package models
+
+type IssueSecurityMemberListResponseModel struct {
+	StartAt    int64                      `json:"startAt"`
+	MaxResults int64                      `json:"maxResults"`
+	IsLast     bool                       `json:"isLast"`
+	Values     []IssueSecurityMemberModel `json:"values"`
+}
+
+type IssueSecurityMemberModel struct {
+	Id                   string                   `json:"id"`
+	IssueSecurityLevelId string                   `json:"issueSecurityLevelId"`
+	Holder               IssueSecurityHolderModel `json:"holder"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type IssueSecuritySchemeCreateRequestModel struct {
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Levels      []IssueSecurityLevelModel `json:"levels"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueSecuritySchemeCreateResponseModel struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueSecuritySchemeDeleteRequestModel struct {
+	Id string
+}
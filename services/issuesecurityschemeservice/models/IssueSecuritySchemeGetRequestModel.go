@@ -0,0 +1,5 @@
+package models
+
+type IssueSecuritySchemeGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,22 @@
+package models
+
+type IssueSecuritySchemeGetResponseModel struct {
+	Id                     int64                     `json:"id"`
+	Name                   string                    `json:"name"`
+	Description            string                    `json:"description"`
+	DefaultSecurityLevelId int64                     `json:"defaultSecurityLevelId"`
+	Levels                 []IssueSecurityLevelModel `json:"levels"`
+}
+
+type IssueSecurityLevelModel struct {
+	Id          string                     `json:"id,omitempty"`
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	IsDefault   bool                       `json:"isDefault,omitempty"`
+	Members     []IssueSecurityHolderModel `json:"members,omitempty"`
+}
+
+type IssueSecurityHolderModel struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter,omitempty"`
+}
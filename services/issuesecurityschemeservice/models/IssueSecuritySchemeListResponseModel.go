@@ -0,0 +1,5 @@
+package models
+
+type IssueSecuritySchemeListResponseModel struct {
+	IssueSecuritySchemes []IssueSecuritySchemeGetResponseModel `json:"issueSecuritySchemes"`
+}
@@ -0,0 +1,25 @@
+package models
+
+type IssueSecuritySchemeUpdateRequestModel struct {
+	Id          string                    `json:"-"`
+	Name        string                    `json:"name"`
+	Description string                    `json:"description"`
+	Levels      []IssueSecurityLevelModel `json:"-"`
+}
+
+type IssueSecurityLevelsApiRequestModel struct {
+	Levels []IssueSecurityLevelModel `json:"levels"`
+}
+
+type IssueSecurityMembersApiRequestModel struct {
+	Members []IssueSecurityHolderModel `json:"members"`
+}
+
+type IssueSecurityDefaultLevelsApiRequestModel struct {
+	DefaultValues []IssueSecurityDefaultLevelApiModel `json:"defaultValues"`
+}
+
+type IssueSecurityDefaultLevelApiModel struct {
+	IssueSecuritySchemeId string `json:"issueSecuritySchemeId"`
+	DefaultLevelId        string `json:"defaultLevelId"`
+}
@@ -0,0 +1,709 @@
+package issuetypeschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"html"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/issuetypeschemeservice/models"
+	"terraform-provider-hashicups-pf/services/issuetypeservice"
+	models3 "terraform-provider-hashicups-pf/services/issuetypeservice/models"
+)
+
+// jira server has no issue type scheme rest api, the admin pages manage schemes as options of the issuetype field
+var (
+	schemeConfigureLinkPattern = regexp.MustCompile(`ConfigureOptionSchemes!default\.jspa\?fieldId=issuetype&(?:amp;)?schemeId=(\d+)`)
+	schemeDeleteLinkPattern    = regexp.MustCompile(`DeleteOptionScheme!default\.jspa\?fieldId=issuetype&(?:amp;)?schemeId=(\d+)`)
+	schemeNamePattern          = regexp.MustCompile(`<input[^>]*name="name"[^>]*>`)
+	schemeDescriptionPattern   = regexp.MustCompile(`(?s)<textarea[^>]*name="description"[^>]*>(.*?)</textarea>`)
+	schemeDefaultPattern       = regexp.MustCompile(`(?s)<select[^>]*name="defaultOption"[^>]*>(.*?)</select>`)
+	schemeIssueTypePattern     = regexp.MustCompile(`<li[^>]*id="selectedOptions_(\d+)"`)
+	schemeProjectsPattern      = regexp.MustCompile(`(?s)<select[^>]*name="projects"[^>]*>(.*?)</select>`)
+	selectedOptionPattern      = regexp.MustCompile(`<option[^>]*>`)
+	attributeValuePattern      = regexp.MustCompile(`value="([^"]*)"`)
+)
+
+type IIssueTypeSchemeService interface {
+	List(ctx context.Context, model models.IssueTypeSchemeListRequestModel) (models.IssueTypeSchemeListResponseModel, error)
+	Get(ctx context.Context, model models.IssueTypeSchemeGetRequestModel) (models.IssueTypeSchemeGetResponseModel, error)
+	Create(ctx context.Context, model models.IssueTypeSchemeCreateRequestModel) (models.IssueTypeSchemeCreateResponseModel, error)
+	Update(ctx context.Context, model models.IssueTypeSchemeUpdateRequestModel) (models.IssueTypeSchemeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.IssueTypeSchemeDeleteRequestModel) (models.IssueTypeSchemeDeleteResponseModel, error)
+}
+
+type IssueTypeSchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (i IssueTypeSchemeService) List(ctx context.Context, model models.IssueTypeSchemeListRequestModel) (models.IssueTypeSchemeListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list issue type schemes w. data: %v", model))
+
+	result := models.IssueTypeSchemeListResponseModel{}
+	startAt := 0
+	for {
+		query := url2.Values{}
+		query.Set("startAt", strconv.Itoa(startAt))
+		if model.Id != "" {
+			query.Set("id", model.Id)
+		}
+
+		body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodGet, "/rest/api/2/issuetypescheme?"+query.Encode(), nil)
+		if errors.Is(err, baseservice.ErrNotFound) || errors.Is(err, baseservice.ErrNotSupported) {
+			tflog.Info(ctx, "issue type scheme endpoint not found")
+			return *new(models.IssueTypeSchemeListResponseModel), baseservice.ErrNotSupported
+		}
+		if err != nil {
+			log.Println("failed to list issue type schemes")
+			return *new(models.IssueTypeSchemeListResponseModel), err
+		}
+
+		page := models.IssueTypeSchemeListResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.IssueTypeSchemeListResponseModel), errors.New("error unmarshalling response body")
+		}
+
+		result.Values = append(result.Values, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	tflog.Info(ctx, "success list issue type schemes")
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) Get(ctx context.Context, model models.IssueTypeSchemeGetRequestModel) (models.IssueTypeSchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get issue type scheme w. data: %v", model))
+
+	useAdminPages, err := i.useAdminPages(ctx)
+	if err != nil {
+		return *new(models.IssueTypeSchemeGetResponseModel), err
+	}
+	if useAdminPages {
+		return i.getFromAdminPages(ctx, model)
+	}
+
+	schemes, err := i.List(ctx, models.IssueTypeSchemeListRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to list issue type schemes")
+		return *new(models.IssueTypeSchemeGetResponseModel), err
+	}
+
+	foundScheme := models.IssueTypeSchemeGetResponseModel{}
+	for _, scheme := range schemes.Values {
+		if scheme.Id == model.Id {
+			foundScheme = scheme
+			break
+		}
+	}
+	if foundScheme.Id == "" {
+		tflog.Info(ctx, "issue type scheme not found")
+		return *new(models.IssueTypeSchemeGetResponseModel), errors.New("failed to find issue type scheme " + model.Id)
+	}
+
+	mappings, err := i.listMappings(ctx, model.Id)
+	if err != nil {
+		log.Println("failed to list issue type scheme mappings")
+		return *new(models.IssueTypeSchemeGetResponseModel), err
+	}
+	foundScheme.IssueTypeIds = []string{}
+	for _, mapping := range mappings.Values {
+		foundScheme.IssueTypeIds = append(foundScheme.IssueTypeIds, mapping.IssueTypeId)
+	}
+
+	foundScheme.ProjectIds = []string{}
+	if len(model.ProjectIds) > 0 {
+		projects, err := i.listProjects(ctx, model.ProjectIds)
+		if err != nil {
+			log.Println("failed to list issue type scheme projects")
+			return *new(models.IssueTypeSchemeGetResponseModel), err
+		}
+		for _, project := range projects.Values {
+			if project.IssueTypeScheme.Id == model.Id {
+				foundScheme.ProjectIds = append(foundScheme.ProjectIds, project.ProjectIds...)
+			}
+		}
+	}
+
+	tflog.Info(ctx, "success get issue type scheme")
+	return foundScheme, nil
+}
+
+func (i IssueTypeSchemeService) Create(ctx context.Context, model models.IssueTypeSchemeCreateRequestModel) (models.IssueTypeSchemeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create issue type scheme w. data: %v", model))
+
+	err := i.validateIssueTypeIds(ctx, append(model.IssueTypeIds, model.DefaultIssueTypeId))
+	if err != nil {
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+
+	useAdminPages, err := i.useAdminPages(ctx)
+	if err != nil {
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+	if useAdminPages {
+		return i.createOnAdminPages(ctx, model)
+	}
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodPost, "/rest/api/2/issuetypescheme", model)
+	if err != nil {
+		log.Println("failed to create issue type scheme")
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+
+	result := models.IssueTypeSchemeCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueTypeSchemeCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	for _, projectId := range model.ProjectIds {
+		err = i.assignProject(ctx, result.Id, projectId)
+		if err != nil {
+			log.Println("failed to assign issue type scheme to project " + projectId)
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create issue type scheme")
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) Update(ctx context.Context, model models.IssueTypeSchemeUpdateRequestModel) (models.IssueTypeSchemeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update issue type scheme w. data: %v", model))
+
+	err := i.validateIssueTypeIds(ctx, append(model.IssueTypeIds, model.DefaultIssueTypeId))
+	if err != nil {
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+
+	useAdminPages, err := i.useAdminPages(ctx)
+	if err != nil {
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+	if useAdminPages {
+		return i.updateOnAdminPages(ctx, model)
+	}
+
+	foundScheme, err := i.Get(ctx, models.IssueTypeSchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get issue type scheme for update")
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+
+	schemePath := "/rest/api/2/issuetypescheme/" + url2.PathEscape(model.Id)
+
+	// new issue types have to be added before they can become the default issue type
+	added := difference(model.IssueTypeIds, foundScheme.IssueTypeIds)
+	if len(added) > 0 {
+		_, err = baseservice.Send(ctx, i.JiraServerBase, http.MethodPut, schemePath+"/issuetype", models.IssueTypeSchemeIssueTypesApiRequestModel{
+			IssueTypeIds: added,
+		})
+		if err != nil {
+			log.Println("failed to add issue types to issue type scheme")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+	}
+
+	// a removed default issue type has to be sent as null, otherwise jira keeps the old one
+	request := models.IssueTypeSchemeUpdateApiRequestModel{
+		Name:        model.Name,
+		Description: model.Description,
+	}
+	if model.DefaultIssueTypeId != "" {
+		request.DefaultIssueTypeId = &model.DefaultIssueTypeId
+	}
+	_, err = baseservice.Send(ctx, i.JiraServerBase, http.MethodPut, schemePath, request)
+	if err != nil {
+		log.Println("failed to update issue type scheme")
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+
+	for _, issueTypeId := range difference(foundScheme.IssueTypeIds, model.IssueTypeIds) {
+		_, err = baseservice.Send(ctx, i.JiraServerBase, http.MethodDelete, schemePath+"/issuetype/"+url2.PathEscape(issueTypeId), nil)
+		if err != nil {
+			log.Println("failed to remove issue type " + issueTypeId + " from issue type scheme")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+	}
+
+	if len(model.IssueTypeIds) > 0 {
+		_, err = baseservice.Send(ctx, i.JiraServerBase, http.MethodPut, schemePath+"/issuetype/move", models.IssueTypeSchemeIssueTypesApiRequestModel{
+			IssueTypeIds: model.IssueTypeIds,
+			Position:     "First",
+		})
+		if err != nil {
+			log.Println("failed to reorder issue types of issue type scheme")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+	}
+
+	for _, projectId := range difference(model.ProjectIds, model.OldProjectIds) {
+		err = i.assignProject(ctx, model.Id, projectId)
+		if err != nil {
+			log.Println("failed to assign issue type scheme to project " + projectId)
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+	}
+
+	detached := difference(model.OldProjectIds, model.ProjectIds)
+	if len(detached) > 0 {
+		defaultSchemeId, err := i.getDefaultSchemeId(ctx)
+		if err != nil {
+			log.Println("failed to find default issue type scheme")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+		for _, projectId := range detached {
+			err = i.assignProject(ctx, defaultSchemeId, projectId)
+			if err != nil {
+				log.Println("failed to move project " + projectId + " back to default issue type scheme")
+				return *new(models.IssueTypeSchemeUpdateResponseModel), err
+			}
+		}
+	}
+
+	log.Println("success update issue type scheme")
+	return models.IssueTypeSchemeUpdateResponseModel{}, nil
+}
+
+func (i IssueTypeSchemeService) Delete(ctx context.Context, model models.IssueTypeSchemeDeleteRequestModel) (models.IssueTypeSchemeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete issue type scheme w. data: %v", model))
+
+	useAdminPages, err := i.useAdminPages(ctx)
+	if err != nil {
+		return *new(models.IssueTypeSchemeDeleteResponseModel), err
+	}
+	if useAdminPages {
+		return i.deleteOnAdminPages(ctx, model)
+	}
+
+	_, err = baseservice.Send(ctx, i.JiraServerBase, http.MethodDelete, "/rest/api/2/issuetypescheme/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "issue type scheme already deleted")
+		return models.IssueTypeSchemeDeleteResponseModel{}, nil
+	}
+	if err != nil {
+		log.Println("failed to delete issue type scheme")
+		return *new(models.IssueTypeSchemeDeleteResponseModel), err
+	}
+
+	log.Println("delete issue type scheme success")
+	return models.IssueTypeSchemeDeleteResponseModel{}, nil
+}
+
+func (i IssueTypeSchemeService) useAdminPages(ctx context.Context) (bool, error) {
+	err := baseservice.CheckEndpoint(ctx, i.JiraServerBase, "/rest/api/2/issuetypescheme?maxResults=1")
+	if errors.Is(err, baseservice.ErrNotSupported) {
+		tflog.Info(ctx, "issue type scheme rest api not available, using the admin pages")
+		return true, nil
+	}
+	return false, err
+}
+
+func (i IssueTypeSchemeService) getDefaultSchemeId(ctx context.Context) (string, error) {
+	schemes, err := i.List(ctx, models.IssueTypeSchemeListRequestModel{})
+	if err != nil {
+		return "", err
+	}
+
+	for _, scheme := range schemes.Values {
+		if scheme.IsDefault {
+			return scheme.Id, nil
+		}
+	}
+	return "", errors.New("jira did not report a default issue type scheme")
+}
+
+func (i IssueTypeSchemeService) listMappings(ctx context.Context, issueTypeSchemeId string) (models.IssueTypeSchemeMappingListResponseModel, error) {
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodGet, "/rest/api/2/issuetypescheme/mapping?maxResults=1000&issueTypeSchemeId="+url2.QueryEscape(issueTypeSchemeId), nil)
+	if err != nil {
+		return *new(models.IssueTypeSchemeMappingListResponseModel), err
+	}
+
+	result := models.IssueTypeSchemeMappingListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueTypeSchemeMappingListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) listProjects(ctx context.Context, projectIds []string) (models.IssueTypeSchemeProjectListResponseModel, error) {
+	query := url2.Values{}
+	for _, projectId := range projectIds {
+		query.Add("projectId", projectId)
+	}
+
+	body, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodGet, "/rest/api/2/issuetypescheme/project?"+query.Encode(), nil)
+	if err != nil {
+		return *new(models.IssueTypeSchemeProjectListResponseModel), err
+	}
+
+	result := models.IssueTypeSchemeProjectListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.IssueTypeSchemeProjectListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) assignProject(ctx context.Context, issueTypeSchemeId string, projectId string) error {
+	_, err := baseservice.Send(ctx, i.JiraServerBase, http.MethodPut, "/rest/api/2/issuetypescheme/project", models.IssueTypeSchemeAssignProjectApiRequestModel{
+		IssueTypeSchemeId: issueTypeSchemeId,
+		ProjectId:         projectId,
+	})
+	return err
+}
+
+func (i IssueTypeSchemeService) validateIssueTypeIds(ctx context.Context, issueTypeIds []string) error {
+	issueTypeService := issuetypeservice.IssueTypeService{
+		JiraServerBase: i.JiraServerBase,
+	}
+
+	issueTypes, err := issueTypeService.List(ctx, models3.IssueTypeListRequestModel{})
+	if err != nil {
+		log.Println("failed to list issue types")
+		return err
+	}
+
+	known := map[string]bool{}
+	for _, issueType := range issueTypes {
+		known[issueType.Id] = true
+	}
+	for _, issueTypeId := range issueTypeIds {
+		if issueTypeId != "" && !known[issueTypeId] {
+			return errors.New("issue type " + issueTypeId + " does not exist")
+		}
+	}
+	return nil
+}
+
+func (i IssueTypeSchemeService) listAdminSchemeIds(ctx context.Context) ([]string, string, error) {
+	body, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodGet, "/secure/admin/ManageIssueTypeSchemes!default.jspa", nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	page := string(body)
+	if !strings.Contains(page, "ConfigureOptionSchemes") {
+		return nil, "", errors.New("failed to read issue type schemes page, the token needs jira administrator rights")
+	}
+
+	// the default scheme is the only one without a delete link
+	deletable := map[string]bool{}
+	for _, match := range schemeDeleteLinkPattern.FindAllStringSubmatch(page, -1) {
+		deletable[match[1]] = true
+	}
+	schemeIds := []string{}
+	defaultSchemeId := ""
+	seen := map[string]bool{}
+	for _, match := range schemeConfigureLinkPattern.FindAllStringSubmatch(page, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		schemeIds = append(schemeIds, match[1])
+		if !deletable[match[1]] {
+			defaultSchemeId = match[1]
+		}
+	}
+	return schemeIds, defaultSchemeId, nil
+}
+
+func (i IssueTypeSchemeService) getFromAdminPages(ctx context.Context, model models.IssueTypeSchemeGetRequestModel) (models.IssueTypeSchemeGetResponseModel, error) {
+	schemeIds, defaultSchemeId, err := i.listAdminSchemeIds(ctx)
+	if err != nil {
+		log.Println("failed to list issue type schemes")
+		return *new(models.IssueTypeSchemeGetResponseModel), err
+	}
+	if !containsString(schemeIds, model.Id) {
+		tflog.Info(ctx, "issue type scheme not found")
+		return *new(models.IssueTypeSchemeGetResponseModel), errors.New("failed to find issue type scheme " + model.Id)
+	}
+
+	form := url2.Values{}
+	form.Set("fieldId", "issuetype")
+	form.Set("schemeId", model.Id)
+	body, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodGet, "/secure/admin/ConfigureOptionSchemes!default.jspa", form)
+	if err != nil {
+		log.Println("failed to read issue type scheme page")
+		return *new(models.IssueTypeSchemeGetResponseModel), err
+	}
+
+	page := string(body)
+	nameInput := schemeNamePattern.FindString(page)
+	defaultSelect := schemeDefaultPattern.FindStringSubmatch(page)
+	if nameInput == "" || defaultSelect == nil {
+		return *new(models.IssueTypeSchemeGetResponseModel), errors.New("failed to read issue type scheme " + model.Id + " page, the token needs jira administrator rights")
+	}
+
+	result := models.IssueTypeSchemeGetResponseModel{
+		Id:           model.Id,
+		Name:         html.UnescapeString(attributeValue(nameInput)),
+		IsDefault:    model.Id == defaultSchemeId,
+		IssueTypeIds: []string{},
+		ProjectIds:   []string{},
+	}
+	if match := schemeDescriptionPattern.FindStringSubmatch(page); match != nil {
+		result.Description = strings.TrimSpace(html.UnescapeString(match[1]))
+	}
+	selected := selectedOptionValues(defaultSelect[1])
+	if len(selected) > 0 {
+		result.DefaultIssueTypeId = selected[0]
+	}
+	for _, match := range schemeIssueTypePattern.FindAllStringSubmatch(page, -1) {
+		result.IssueTypeIds = append(result.IssueTypeIds, match[1])
+	}
+
+	if len(model.ProjectIds) > 0 {
+		projectIds, err := i.listAdminProjects(ctx, model.Id)
+		if err != nil {
+			log.Println("failed to list issue type scheme projects")
+			return *new(models.IssueTypeSchemeGetResponseModel), err
+		}
+		for _, projectId := range projectIds {
+			if containsString(model.ProjectIds, projectId) {
+				result.ProjectIds = append(result.ProjectIds, projectId)
+			}
+		}
+	}
+
+	tflog.Info(ctx, "success get issue type scheme")
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) createOnAdminPages(ctx context.Context, model models.IssueTypeSchemeCreateRequestModel) (models.IssueTypeSchemeCreateResponseModel, error) {
+	schemeIdsBefore, _, err := i.listAdminSchemeIds(ctx)
+	if err != nil {
+		log.Println("failed to list issue type schemes")
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+
+	err = i.saveOnAdminPage(ctx, "", model.Name, model.Description, model.DefaultIssueTypeId, model.IssueTypeIds)
+	if err != nil {
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+
+	// the form does not answer with the new id, it is the one scheme that was not there before
+	schemeIdsAfter, _, err := i.listAdminSchemeIds(ctx)
+	if err != nil {
+		log.Println("failed to list issue type schemes")
+		return *new(models.IssueTypeSchemeCreateResponseModel), err
+	}
+	result := models.IssueTypeSchemeCreateResponseModel{}
+	for _, schemeId := range difference(schemeIdsAfter, schemeIdsBefore) {
+		result.Id = schemeId
+	}
+	if result.Id == "" {
+		return *new(models.IssueTypeSchemeCreateResponseModel), errors.New("jira did not create issue type scheme " + model.Name + ", check that the name is unique")
+	}
+
+	err = i.verifyOnAdminPages(ctx, result.Id, model.Name, model.Description, model.DefaultIssueTypeId, model.IssueTypeIds)
+	if err != nil {
+		return result, err
+	}
+
+	if len(model.ProjectIds) > 0 {
+		err = i.associateOnAdminPage(ctx, result.Id, model.ProjectIds)
+		if err != nil {
+			log.Println("failed to assign issue type scheme to projects")
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create issue type scheme")
+	return result, nil
+}
+
+func (i IssueTypeSchemeService) updateOnAdminPages(ctx context.Context, model models.IssueTypeSchemeUpdateRequestModel) (models.IssueTypeSchemeUpdateResponseModel, error) {
+	err := i.saveOnAdminPage(ctx, model.Id, model.Name, model.Description, model.DefaultIssueTypeId, model.IssueTypeIds)
+	if err != nil {
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+
+	err = i.verifyOnAdminPages(ctx, model.Id, model.Name, model.Description, model.DefaultIssueTypeId, model.IssueTypeIds)
+	if err != nil {
+		return *new(models.IssueTypeSchemeUpdateResponseModel), err
+	}
+
+	detached := difference(model.OldProjectIds, model.ProjectIds)
+	added := difference(model.ProjectIds, model.OldProjectIds)
+	if len(detached) > 0 || len(added) > 0 {
+		// the association form replaces the project list, projects left out fall back to the default scheme
+		currentProjectIds, err := i.listAdminProjects(ctx, model.Id)
+		if err != nil {
+			log.Println("failed to list issue type scheme projects")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+		projectIds := append(difference(currentProjectIds, append(detached, added...)), added...)
+		err = i.associateOnAdminPage(ctx, model.Id, projectIds)
+		if err != nil {
+			log.Println("failed to assign issue type scheme to projects")
+			return *new(models.IssueTypeSchemeUpdateResponseModel), err
+		}
+	}
+
+	log.Println("success update issue type scheme")
+	return models.IssueTypeSchemeUpdateResponseModel{}, nil
+}
+
+func (i IssueTypeSchemeService) deleteOnAdminPages(ctx context.Context, model models.IssueTypeSchemeDeleteRequestModel) (models.IssueTypeSchemeDeleteResponseModel, error) {
+	form := url2.Values{}
+	form.Set("fieldId", "issuetype")
+	form.Set("schemeId", model.Id)
+	form.Set("confirm", "true")
+	_, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodPost, "/secure/admin/DeleteOptionScheme.jspa", form)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete issue type scheme")
+		return *new(models.IssueTypeSchemeDeleteResponseModel), err
+	}
+
+	schemeIds, _, err := i.listAdminSchemeIds(ctx)
+	if err != nil {
+		log.Println("failed to list issue type schemes")
+		return *new(models.IssueTypeSchemeDeleteResponseModel), err
+	}
+	if containsString(schemeIds, model.Id) {
+		return *new(models.IssueTypeSchemeDeleteResponseModel), errors.New("jira did not delete issue type scheme " + model.Id + ", the default issue type scheme cannot be deleted")
+	}
+
+	log.Println("delete issue type scheme success")
+	return models.IssueTypeSchemeDeleteResponseModel{}, nil
+}
+
+func (i IssueTypeSchemeService) saveOnAdminPage(ctx context.Context, schemeId string, name string, description string, defaultIssueTypeId string, issueTypeIds []string) error {
+	form := url2.Values{}
+	form.Set("fieldId", "issuetype")
+	form.Set("schemeId", schemeId)
+	form.Set("name", name)
+	form.Set("description", description)
+	form.Set("defaultOption", defaultIssueTypeId)
+	for _, issueTypeId := range issueTypeIds {
+		form.Add("selectedOptions", issueTypeId)
+	}
+	form.Set("save", "Save")
+
+	_, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodPost, "/secure/admin/ConfigureOptionSchemes.jspa", form)
+	if err != nil {
+		log.Println("failed to save issue type scheme")
+	}
+	return err
+}
+
+// verifyOnAdminPages reads the scheme back, the admin forms answer validation errors with a 200 page
+func (i IssueTypeSchemeService) verifyOnAdminPages(ctx context.Context, schemeId string, name string, description string, defaultIssueTypeId string, issueTypeIds []string) error {
+	foundScheme, err := i.getFromAdminPages(ctx, models.IssueTypeSchemeGetRequestModel{
+		Id: schemeId,
+	})
+	if err != nil {
+		return err
+	}
+
+	if foundScheme.Name != name || foundScheme.Description != description || foundScheme.DefaultIssueTypeId != defaultIssueTypeId || strings.Join(foundScheme.IssueTypeIds, ",") != strings.Join(issueTypeIds, ",") {
+		return errors.New("jira did not accept the changes to issue type scheme " + schemeId + ", check that the name is unique and the default issue type is part of issue_type_ids")
+	}
+	return nil
+}
+
+func (i IssueTypeSchemeService) listAdminProjects(ctx context.Context, schemeId string) ([]string, error) {
+	form := url2.Values{}
+	form.Set("fieldId", "issuetype")
+	form.Set("schemeId", schemeId)
+	body, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodGet, "/secure/admin/AssociateIssueTypeSchemes!default.jspa", form)
+	if err != nil {
+		return nil, err
+	}
+
+	match := schemeProjectsPattern.FindStringSubmatch(string(body))
+	if match == nil {
+		return nil, errors.New("failed to read projects of issue type scheme " + schemeId + ", the token needs jira administrator rights")
+	}
+	return selectedOptionValues(match[1]), nil
+}
+
+func (i IssueTypeSchemeService) associateOnAdminPage(ctx context.Context, schemeId string, projectIds []string) error {
+	form := url2.Values{}
+	form.Set("fieldId", "issuetype")
+	form.Set("schemeId", schemeId)
+	for _, projectId := range projectIds {
+		form.Add("projects", projectId)
+	}
+	form.Set("Associate", "Associate")
+
+	_, err := baseservice.SendForm(ctx, i.JiraServerBase, http.MethodPost, "/secure/admin/AssociateIssueTypeSchemes.jspa", form)
+	if err != nil {
+		return err
+	}
+
+	foundProjectIds, err := i.listAdminProjects(ctx, schemeId)
+	if err != nil {
+		return err
+	}
+	if len(difference(projectIds, foundProjectIds)) > 0 || len(difference(foundProjectIds, projectIds)) > 0 {
+		return errors.New("jira did not associate issue type scheme " + schemeId + " with projects " + strings.Join(projectIds, ", ") + ", check that the issue types are not in use by the projects")
+	}
+	return nil
+}
+
+func attributeValue(tag string) string {
+	match := attributeValuePattern.FindStringSubmatch(tag)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+func selectedOptionValues(options string) []string {
+	result := []string{}
+	for _, option := range selectedOptionPattern.FindAllString(options, -1) {
+		if strings.Contains(option, "selected") {
+			result = append(result, attributeValue(option))
+		}
+	}
+	return result
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func difference(a []string, b []string) []string {
+	inB := map[string]bool{}
+	for _, v := range b {
+		inB[v] = true
+	}
+	result := []string{}
+	for _, v := range a {
+		if !inB[v] {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+package models
+
+type IssueTypeSchemeAssignProjectApiRequestModel struct {
+	IssueTypeSchemeId string `json:"issueTypeSchemeId"`
+	ProjectId         string `json:"projectId"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type IssueTypeSchemeCreateRequestModel struct {
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	DefaultIssueTypeId string   `json:"defaultIssueTypeId,omitempty"`
+	IssueTypeIds       []string `json:"issueTypeIds"`
+	ProjectIds         []string `json:"-"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueTypeSchemeCreateResponseModel struct {
+	Id string `json:"issueTypeSchemeId"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueTypeSchemeDeleteRequestModel struct {
+	Id string
+}
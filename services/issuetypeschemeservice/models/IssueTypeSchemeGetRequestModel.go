@@ -0,0 +1,6 @@
+package models
+
+type IssueTypeSchemeGetRequestModel struct {
+	Id         string
+	ProjectIds []string
+}
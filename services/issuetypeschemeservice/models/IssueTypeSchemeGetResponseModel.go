@@ -0,0 +1,11 @@
+package models
+
+type IssueTypeSchemeGetResponseModel struct {
+	Id                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	DefaultIssueTypeId string   `json:"defaultIssueTypeId"`
+	IsDefault          bool     `json:"isDefault"`
+	IssueTypeIds       []string `json:"-"`
+	ProjectIds         []string `json:"-"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type IssueTypeSchemeIssueTypesApiRequestModel struct {
+	IssueTypeIds []string `json:"issueTypeIds"`
+	Position     string   `json:"position,omitempty"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type IssueTypeSchemeListRequestModel struct {
+	Id string
+}
@@ -0,0 +1,6 @@
+package models
+
+type IssueTypeSchemeListResponseModel struct {
+	IsLast bool                              `json:"isLast"`
+	Values []IssueTypeSchemeGetResponseModel `json:"values"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type IssueTypeSchemeMappingListResponseModel struct {
+	IsLast bool                          `json:"isLast"`
+	Values []IssueTypeSchemeMappingModel `json:"values"`
+}
+
+type IssueTypeSchemeMappingModel struct {
+	IssueTypeSchemeId string `json:"issueTypeSchemeId"`
+	IssueTypeId       string `json:"issueTypeId"`
+}
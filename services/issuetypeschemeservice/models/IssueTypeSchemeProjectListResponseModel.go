@@ -0,0 +1,10 @@
+package models
+
+type IssueTypeSchemeProjectListResponseModel struct {
+	Values []IssueTypeSchemeProjectModel `json:"values"`
+}
+
+type IssueTypeSchemeProjectModel struct {
+	IssueTypeScheme IssueTypeSchemeGetResponseModel `json:"issueTypeScheme"`
+	ProjectIds      []string                        `json:"projectIds"`
+}
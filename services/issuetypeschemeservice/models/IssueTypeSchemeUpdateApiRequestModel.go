@@ -0,0 +1,7 @@
+package models
+
+type IssueTypeSchemeUpdateApiRequestModel struct {
+	Name               string  `json:"name"`
+	Description        string  `json:"description"`
+	DefaultIssueTypeId *string `json:"defaultIssueTypeId"`
+}
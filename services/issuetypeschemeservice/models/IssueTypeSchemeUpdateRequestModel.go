@@ -0,0 +1,11 @@
+package models
+
+type IssueTypeSchemeUpdateRequestModel struct {
+	Id                 string
+	Name               string
+	Description        string
+	DefaultIssueTypeId string
+	IssueTypeIds       []string
+	ProjectIds         []string
+	OldProjectIds      []string
+}
@@ -27,7 +27,7 @@ type IssueTypeService struct {
 }
 
 func (i IssueTypeService) List(ctx context.Context, model models.IssueTypeListRequestModel) (models.IssueTypeListResponseModel, error) {
-	log.Printf("start list issue types w. data: %s", model)
+	log.Printf("start list issue types w. data: %v", model)
 
 	url := "https://" + i.JiraServerBase.Domain + "/rest/api/2/issuetype"
 	log.Println(url)
@@ -70,7 +70,7 @@ func (i IssueTypeService) List(ctx context.Context, model models.IssueTypeListRe
 }
 
 func (i IssueTypeService) Get(ctx context.Context, model models.IssueTypeGetRequestModel) (models.IssueTypeGetResponseModel, error) {
-	log.Printf("start get issue type w. data: %s", model)
+	log.Printf("start get issue type w. data: %v", model)
 
 	issueTypes, err := i.List(ctx, models.IssueTypeListRequestModel{})
 	if err != nil {
@@ -96,7 +96,7 @@ func (i IssueTypeService) Get(ctx context.Context, model models.IssueTypeGetRequ
 }
 
 func (i IssueTypeService) Create(ctx context.Context, model models.IssueTypeCreateRequestModel) (models.IssueTypeCreateResponseModel, error) {
-	log.Printf("start create issue type w. data: %s", model)
+	log.Printf("start create issue type w. data: %v", model)
 
 	model.Type = "standard"
 	serialized, err := json.Marshal(model)
@@ -161,7 +161,7 @@ func (i IssueTypeService) Create(ctx context.Context, model models.IssueTypeCrea
 }
 
 func (i IssueTypeService) Update(ctx context.Context, model models.IssueTypeUpdateRequestModel) (models.IssueTypeUpdateResponseModel, error) {
-	log.Printf("start update issue type w. data: %s", model)
+	log.Printf("start update issue type w. data: %v", model)
 
 	foundIssueType, err := i.Get(ctx, models.IssueTypeGetRequestModel{
 		Id: model.Id,
@@ -220,7 +220,7 @@ func (i IssueTypeService) Update(ctx context.Context, model models.IssueTypeUpda
 }
 
 func (i IssueTypeService) Delete(ctx context.Context, model models.IssueTypeDeleteRequestModel) (models.IssueTypeDeleteResponseModel, error) {
-	log.Printf("start delete issue type w. data: %s", model)
+	log.Printf("start delete issue type w. data: %v", model)
 
 	foundIssueType, err := i.Get(ctx, models.IssueTypeGetRequestModel{
 		Id: model.Id,
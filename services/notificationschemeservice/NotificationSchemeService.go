@@ -0,0 +1,305 @@
+package notificationschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"sort"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/notificationschemeservice/models"
+)
+
+// jira server only reads notification schemes through the rest api, writes go through the admin forms
+// which name the recipient types differently, the recipient parameter is posted under the form type.
+var recipientFormTypes = map[string]string{
+	"CurrentAssignee":  "Current_Assignee",
+	"Reporter":         "Current_Reporter",
+	"CurrentUser":      "Remote_User",
+	"ProjectLead":      "Project_Lead",
+	"ComponentLead":    "Component_Lead",
+	"User":             "Single_User",
+	"Group":            "Group_Dropdown",
+	"ProjectRole":      "Project_Role",
+	"EmailAddress":     "Single_Email_Address",
+	"AllWatchers":      "All_Watchers",
+	"UserCustomField":  "User_Custom_Field_Value",
+	"GroupCustomField": "Group_Custom_Field_Value",
+}
+
+type INotificationSchemeService interface {
+	Get(ctx context.Context, model models.NotificationSchemeGetRequestModel) (models.NotificationSchemeGetResponseModel, error)
+	List(ctx context.Context, model models.NotificationSchemeListRequestModel) (models.NotificationSchemeListResponseModel, error)
+	Create(ctx context.Context, model models.NotificationSchemeCreateRequestModel) (models.NotificationSchemeCreateResponseModel, error)
+	Update(ctx context.Context, model models.NotificationSchemeUpdateRequestModel) (models.NotificationSchemeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.NotificationSchemeDeleteRequestModel) (models.NotificationSchemeDeleteResponseModel, error)
+}
+
+type NotificationSchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (n NotificationSchemeService) Get(ctx context.Context, model models.NotificationSchemeGetRequestModel) (models.NotificationSchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get notification scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, n.JiraServerBase, http.MethodGet, "/rest/api/2/notificationscheme/"+url2.PathEscape(model.Id)+"?expand=all", nil)
+	if err != nil {
+		log.Println("failed to get notification scheme")
+		return *new(models.NotificationSchemeGetResponseModel), err
+	}
+
+	result := models.NotificationSchemeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.NotificationSchemeGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// jira returns recipients in insertion order, flatten and sort them so reads are stable
+	notifications := []models.NotificationModel{}
+	for _, event := range result.NotificationSchemeEvents {
+		for _, recipient := range event.Notifications {
+			notifications = append(notifications, models.NotificationModel{
+				Id:      recipient.Id,
+				EventId: event.Event.Id,
+				Recipient: models.NotificationRecipientModel{
+					Type:      recipient.Type,
+					Parameter: recipient.Parameter,
+				},
+			})
+		}
+	}
+	sort.Slice(notifications, func(a, b int) bool {
+		return notificationKey(notifications[a]) < notificationKey(notifications[b])
+	})
+	result.Notifications = notifications
+
+	tflog.Info(ctx, "success get notification scheme")
+	return result, nil
+}
+
+func (n NotificationSchemeService) List(ctx context.Context, model models.NotificationSchemeListRequestModel) (models.NotificationSchemeListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list notification schemes w. data: %v", model))
+
+	result := models.NotificationSchemeListResponseModel{}
+	startAt := 0
+	for {
+		body, err := baseservice.Send(ctx, n.JiraServerBase, http.MethodGet, "/rest/api/2/notificationscheme?startAt="+strconv.Itoa(startAt), nil)
+		if err != nil {
+			log.Println("failed to list notification schemes")
+			return *new(models.NotificationSchemeListResponseModel), err
+		}
+
+		page := models.NotificationSchemeListApiResponseModel{}
+		err = json.Unmarshal(body, &page)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.NotificationSchemeListResponseModel), errors.New("error unmarshalling response body")
+		}
+
+		result.NotificationSchemes = append(result.NotificationSchemes, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	tflog.Info(ctx, "success list notification schemes")
+	return result, nil
+}
+
+func (n NotificationSchemeService) Create(ctx context.Context, model models.NotificationSchemeCreateRequestModel) (models.NotificationSchemeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create notification scheme w. data: %v", model))
+
+	form := url2.Values{}
+	form.Set("name", model.Name)
+	form.Set("description", model.Description)
+	_, err := baseservice.SendForm(ctx, n.JiraServerBase, http.MethodPost, "/secure/admin/AddNotificationScheme.jspa", form)
+	if err != nil {
+		log.Println("failed to add notification scheme")
+		return *new(models.NotificationSchemeCreateResponseModel), err
+	}
+
+	// the admin action answers with an html page either way, scheme names are unique so look the new one up by name
+	schemes, err := n.List(ctx, models.NotificationSchemeListRequestModel{})
+	if err != nil {
+		log.Println("failed to list notification schemes")
+		return *new(models.NotificationSchemeCreateResponseModel), err
+	}
+	schemeId := ""
+	for _, scheme := range schemes.NotificationSchemes {
+		if scheme.Name == model.Name {
+			schemeId = strconv.FormatInt(scheme.Id, 10)
+			break
+		}
+	}
+	if schemeId == "" {
+		return *new(models.NotificationSchemeCreateResponseModel), errors.New("jira did not create notification scheme " + model.Name + ", check that the name is unused")
+	}
+
+	_, err = n.Update(ctx, models.NotificationSchemeUpdateRequestModel{
+		Id:            schemeId,
+		Name:          model.Name,
+		Description:   model.Description,
+		Notifications: model.Notifications,
+	})
+	if err != nil {
+		log.Println("failed to add notifications to created notification scheme")
+		return models.NotificationSchemeCreateResponseModel{
+			Id: schemeId,
+		}, err
+	}
+
+	tflog.Info(ctx, "success create notification scheme")
+	return models.NotificationSchemeCreateResponseModel{
+		Id: schemeId,
+	}, nil
+}
+
+func (n NotificationSchemeService) Update(ctx context.Context, model models.NotificationSchemeUpdateRequestModel) (models.NotificationSchemeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update notification scheme w. data: %v", model))
+
+	foundScheme, err := n.Get(ctx, models.NotificationSchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get notification scheme")
+		return *new(models.NotificationSchemeUpdateResponseModel), err
+	}
+
+	if foundScheme.Name != model.Name || foundScheme.Description != model.Description {
+		form := url2.Values{}
+		form.Set("schemeId", model.Id)
+		form.Set("name", model.Name)
+		form.Set("description", model.Description)
+		_, err = baseservice.SendForm(ctx, n.JiraServerBase, http.MethodPost, "/secure/admin/EditNotificationScheme.jspa", form)
+		if err != nil {
+			log.Println("failed to edit notification scheme")
+			return *new(models.NotificationSchemeUpdateResponseModel), err
+		}
+	}
+
+	wanted := map[string]bool{}
+	for _, notification := range model.Notifications {
+		wanted[notificationKey(notification)] = true
+	}
+	existing := map[string]bool{}
+	for _, notification := range foundScheme.Notifications {
+		existing[notificationKey(notification)] = true
+		if wanted[notificationKey(notification)] {
+			continue
+		}
+
+		form := url2.Values{}
+		form.Set("schemeId", model.Id)
+		form.Set("id", strconv.FormatInt(notification.Id, 10))
+		form.Set("confirmed", "true")
+		_, err = baseservice.SendForm(ctx, n.JiraServerBase, http.MethodPost, "/secure/admin/DeleteNotification.jspa", form)
+		if err != nil {
+			log.Println("failed to delete notification")
+			return *new(models.NotificationSchemeUpdateResponseModel), err
+		}
+	}
+
+	for _, notification := range model.Notifications {
+		if existing[notificationKey(notification)] {
+			continue
+		}
+
+		formType, ok := recipientFormTypes[notification.Recipient.Type]
+		if !ok {
+			return *new(models.NotificationSchemeUpdateResponseModel), errors.New("unknown notification recipient type " + notification.Recipient.Type)
+		}
+		form := url2.Values{}
+		form.Set("schemeId", model.Id)
+		form.Set("eventTypeIds", strconv.FormatInt(notification.EventId, 10))
+		form.Set("type", formType)
+		if notification.Recipient.Parameter != "" {
+			form.Set(formType, notification.Recipient.Parameter)
+		}
+		_, err = baseservice.SendForm(ctx, n.JiraServerBase, http.MethodPost, "/secure/admin/AddNotification.jspa", form)
+		if err != nil {
+			log.Println("failed to add notification")
+			return *new(models.NotificationSchemeUpdateResponseModel), err
+		}
+	}
+
+	// the admin actions answer with an html page either way, check the scheme now matches
+	err = n.verify(ctx, model)
+	if err != nil {
+		return *new(models.NotificationSchemeUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update notification scheme")
+	return models.NotificationSchemeUpdateResponseModel{}, nil
+}
+
+func (n NotificationSchemeService) Delete(ctx context.Context, model models.NotificationSchemeDeleteRequestModel) (models.NotificationSchemeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete notification scheme w. data: %v", model))
+
+	form := url2.Values{}
+	form.Set("schemeId", model.Id)
+	form.Set("confirmed", "true")
+	_, err := baseservice.SendForm(ctx, n.JiraServerBase, http.MethodPost, "/secure/admin/DeleteNotificationScheme.jspa", form)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete notification scheme")
+		return *new(models.NotificationSchemeDeleteResponseModel), err
+	}
+
+	_, err = n.Get(ctx, models.NotificationSchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err == nil {
+		return *new(models.NotificationSchemeDeleteResponseModel), errors.New("jira did not delete notification scheme " + model.Id + ", a scheme still used by projects cannot be deleted")
+	}
+	if !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to get deleted notification scheme")
+		return *new(models.NotificationSchemeDeleteResponseModel), err
+	}
+
+	log.Println("delete notification scheme success")
+	return models.NotificationSchemeDeleteResponseModel{}, nil
+}
+
+// verify re-reads the scheme and compares it with what was written, notifications are compared by event and recipient.
+func (n NotificationSchemeService) verify(ctx context.Context, model models.NotificationSchemeUpdateRequestModel) error {
+	foundScheme, err := n.Get(ctx, models.NotificationSchemeGetRequestModel{
+		Id: model.Id,
+	})
+	if err != nil {
+		log.Println("failed to get updated notification scheme")
+		return err
+	}
+
+	if foundScheme.Name != model.Name || foundScheme.Description != model.Description {
+		return errors.New("jira did not update name or description of notification scheme " + model.Id + ", check that the name is unused")
+	}
+
+	found := map[string]bool{}
+	for _, notification := range foundScheme.Notifications {
+		found[notificationKey(notification)] = true
+	}
+	wanted := map[string]bool{}
+	for _, notification := range model.Notifications {
+		wanted[notificationKey(notification)] = true
+		if !found[notificationKey(notification)] {
+			return fmt.Errorf("jira did not add recipient %s %s for event %d to notification scheme %s, check the event id and recipient", notification.Recipient.Type, notification.Recipient.Parameter, notification.EventId, model.Id)
+		}
+	}
+	for _, notification := range foundScheme.Notifications {
+		if !wanted[notificationKey(notification)] {
+			return fmt.Errorf("jira did not remove recipient %s %s for event %d from notification scheme %s", notification.Recipient.Type, notification.Recipient.Parameter, notification.EventId, model.Id)
+		}
+	}
+	return nil
+}
+
+func notificationKey(notification models.NotificationModel) string {
+	return fmt.Sprintf("%010d/%s/%s", notification.EventId, notification.Recipient.Type, notification.Recipient.Parameter)
+}
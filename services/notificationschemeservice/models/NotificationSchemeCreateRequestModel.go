@@ -0,0 +1,7 @@
+package models
+
+type NotificationSchemeCreateRequestModel struct {
+	Name          string
+	Description   string
+	Notifications []NotificationModel
+}
@@ -0,0 +1,5 @@
+package models
+
+type NotificationSchemeCreateResponseModel struct {
+	Id string
+}
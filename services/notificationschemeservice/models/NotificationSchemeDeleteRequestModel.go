@@ -0,0 +1,5 @@
+package models
+
+type NotificationSchemeDeleteRequestModel struct {
+	Id string
+}
@@ -0,0 +1,5 @@
+package models
+
+type NotificationSchemeGetRequestModel struct {
+	Id string
+}
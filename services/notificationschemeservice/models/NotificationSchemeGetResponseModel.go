@@ -0,0 +1,31 @@
+package models
+
+type NotificationSchemeGetResponseModel struct {
+	Id                       int64                          `json:"id"`
+	Name                     string                         `json:"name"`
+	Description              string                         `json:"description"`
+	NotificationSchemeEvents []NotificationSchemeEventModel `json:"notificationSchemeEvents"`
+	Notifications            []NotificationModel            `json:"-"`
+}
+
+type NotificationSchemeEventModel struct {
+	Event         NotificationEventModel       `json:"event"`
+	Notifications []NotificationRecipientModel `json:"notifications"`
+}
+
+type NotificationEventModel struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+type NotificationModel struct {
+	Id        int64                      `json:"-"`
+	EventId   int64                      `json:"eventId"`
+	Recipient NotificationRecipientModel `json:"recipient"`
+}
+
+type NotificationRecipientModel struct {
+	Id        int64  `json:"id,omitempty"`
+	Type      string `json:"notificationType"`
+	Parameter string `json:"parameter,omitempty"`
+}
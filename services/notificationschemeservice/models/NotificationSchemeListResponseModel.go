@@ -0,0 +1,12 @@
+package models
+
+type NotificationSchemeListResponseModel struct {
+	NotificationSchemes []NotificationSchemeGetResponseModel
+}
+
+type NotificationSchemeListApiResponseModel struct {
+	StartAt    int                                  `json:"startAt"`
+	MaxResults int                                  `json:"maxResults"`
+	IsLast     bool                                 `json:"isLast"`
+	Values     []NotificationSchemeGetResponseModel `json:"values"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type NotificationSchemeUpdateRequestModel struct {
+	Id            string
+	Name          string
+	Description   string
+	Notifications []NotificationModel
+}
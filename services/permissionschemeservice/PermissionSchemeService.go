@@ -29,30 +29,58 @@ type PermissionSchemeService struct {
 }
 
 func (p PermissionSchemeService) Get(ctx context.Context, model models.PermissionSchemeGetRequestModel) (models.PermissionSchemeGetResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start get permission scheme w. data: %s", model))
-	permissionSchemes, err := p.List(ctx, models.PermissionSchemeListRequestModel{})
+	tflog.Info(ctx, fmt.Sprintf("start get permission scheme w. data: %v", model))
+
+	// expanded holders carry the user, group, role and field details of every grant in one call
+	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/permissionscheme/" + strconv.FormatInt(model.Id, 10) + "?expand=permissions,user,group,projectRole,field,all"
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		tflog.Info(ctx, "error building http request")
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("error building http request")
+	}
+	req.Header.Set("Authorization", p.JiraServerBase.AuthorizationMethod+" "+p.JiraServerBase.Token)
+	req.Header.Set("Accept", "application/json")
+
+	client := http.Client{
+		Timeout: time.Second * 30,
+	}
+	res, err := client.Do(req)
 	if err != nil {
-		log.Println("failed to list permission schemes")
-		return *new(models.PermissionSchemeGetResponseModel), errors.New("failed to list roles")
+		tflog.Info(ctx, err.Error())
+		tflog.Info(ctx, "http request failed")
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("http request returned error")
 	}
+	defer res.Body.Close()
 
-	foundPermissionScheme := models.PermissionSchemeGetResponseModel{}
-	for _, ps := range permissionSchemes.PermissionSchemes {
-		if ps.Id == model.Id {
-			foundPermissionScheme = ps
-		}
+	tflog.Info(ctx, res.Status)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		tflog.Info(ctx, "read all body failed")
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("error reading response body")
 	}
-	if foundPermissionScheme.Name == "" {
+
+	tflog.Info(ctx, "response body: "+string(body))
+	if res.StatusCode == http.StatusNotFound {
 		tflog.Info(ctx, "permission scheme not found")
-		return *new(models.PermissionSchemeGetResponseModel), errors.New("failed to list roles")
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("failed to find permission scheme " + strconv.FormatInt(model.Id, 10))
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("get permission scheme returned " + res.Status + ": " + string(body))
+	}
+
+	result := models.PermissionSchemeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.PermissionSchemeGetResponseModel), errors.New("error unmarshalling response body")
 	}
 
 	tflog.Info(ctx, "permission scheme found")
-	return foundPermissionScheme, nil
+	return result, nil
 }
 
 func (p PermissionSchemeService) List(ctx context.Context, model models.PermissionSchemeListRequestModel) (models.PermissionSchemeListResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list permission schemes w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start list permission schemes w. data: %v", model))
 
 	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/permissionscheme"
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -94,7 +122,7 @@ func (p PermissionSchemeService) List(ctx context.Context, model models.Permissi
 }
 
 func (p PermissionSchemeService) Create(ctx context.Context, model models.PermissionSchemeCreateRequestModel) (models.PermissionSchemeCreateResponseModel, error) {
-	log.Printf("start create permission scheme w. data: %s", model)
+	log.Printf("start create permission scheme w. data: %v", model)
 
 	serialized, err := json.Marshal(model)
 	if err != nil {
@@ -143,7 +171,7 @@ func (p PermissionSchemeService) Create(ctx context.Context, model models.Permis
 }
 
 func (p PermissionSchemeService) Update(ctx context.Context, model models.PermissionSchemeUpdateRequestModel) (models.PermissionSchemeUpdateResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start update permission scheme w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start update permission scheme w. data: %v", model))
 
 	permissionScheme, err := p.Get(ctx, models.PermissionSchemeGetRequestModel{
 		Id: model.Id,
@@ -203,7 +231,7 @@ func (p PermissionSchemeService) Update(ctx context.Context, model models.Permis
 }
 
 func (p PermissionSchemeService) Delete(ctx context.Context, model models.PermissionSchemeDeleteRequestModel) (models.PermissionSchemeDeleteResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start delete permission scheme w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start delete permission scheme w. data: %v", model))
 
 	permissionScheme, err := p.Get(ctx, models.PermissionSchemeGetRequestModel{
 		Id: model.Id,
@@ -1,7 +1,18 @@
 package models
 
 type PermissionSchemeCreateRequestModel struct {
-	Id          int64  `json:"id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	Id          int64                        `json:"id"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Permissions []PermissionSchemeGrantModel `json:"permissions,omitempty"`
+}
+
+type PermissionSchemeGrantModel struct {
+	Permission string                      `json:"permission"`
+	Holder     PermissionSchemeHolderModel `json:"holder"`
+}
+
+type PermissionSchemeHolderModel struct {
+	Type      string `json:"type"`
+	Parameter string `json:"parameter,omitempty"`
 }
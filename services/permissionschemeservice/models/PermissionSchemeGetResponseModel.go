@@ -1,7 +1,32 @@
 package models
 
 type PermissionSchemeGetResponseModel struct {
+	Name        string                               `json:"name"`
+	Description string                               `json:"description"`
+	Id          int64                                `json:"id"`
+	Permissions []PermissionSchemeGrantResponseModel `json:"permissions"`
+}
+
+type PermissionSchemeGrantResponseModel struct {
+	Id         int64                               `json:"id"`
+	Permission string                              `json:"permission"`
+	Holder     PermissionSchemeHolderResponseModel `json:"holder"`
+}
+
+type PermissionSchemeHolderResponseModel struct {
+	Type        string                            `json:"type"`
+	Parameter   string                            `json:"parameter"`
+	User        *PermissionSchemeHolderUserModel  `json:"user,omitempty"`
+	Group       *PermissionSchemeHolderNamedModel `json:"group,omitempty"`
+	ProjectRole *PermissionSchemeHolderNamedModel `json:"projectRole,omitempty"`
+	Field       *PermissionSchemeHolderNamedModel `json:"field,omitempty"`
+}
+
+type PermissionSchemeHolderUserModel struct {
 	Name        string `json:"name"`
-	Description string `json:"description"`
-	Id          int64  `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+type PermissionSchemeHolderNamedModel struct {
+	Name string `json:"name"`
 }
@@ -0,0 +1,166 @@
+package priorityservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/priorityservice/models"
+)
+
+type IPriorityService interface {
+	List(ctx context.Context, model models.PriorityListRequestModel) (models.PriorityListResponseModel, error)
+	Get(ctx context.Context, model models.PriorityGetRequestModel) (models.PriorityGetResponseModel, error)
+	Create(ctx context.Context, model models.PriorityCreateRequestModel) (models.PriorityCreateResponseModel, error)
+	Update(ctx context.Context, model models.PriorityUpdateRequestModel) (models.PriorityUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.PriorityDeleteRequestModel) (models.PriorityDeleteResponseModel, error)
+}
+
+type PriorityService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (p PriorityService) List(ctx context.Context, model models.PriorityListRequestModel) (models.PriorityListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list priorities w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/priority", nil)
+	if err != nil {
+		log.Println("failed to list priorities")
+		return *new(models.PriorityListResponseModel), err
+	}
+
+	result := models.PriorityListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.PriorityListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// jira lists priorities in their configured order, keep it on each entry
+	for index := range result {
+		result[index].Sequence = int64(index + 1)
+		if index > 0 {
+			result[index].AfterPriorityId = result[index-1].Id
+		}
+		result[index].Last = index == len(result)-1
+	}
+
+	tflog.Info(ctx, "success list priorities")
+	return result, nil
+}
+
+func (p PriorityService) Get(ctx context.Context, model models.PriorityGetRequestModel) (models.PriorityGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get priority w. data: %v", model))
+
+	priorities, err := p.List(ctx, models.PriorityListRequestModel{})
+	if err != nil {
+		log.Println("failed to list priorities")
+		return *new(models.PriorityGetResponseModel), err
+	}
+
+	for _, priority := range priorities {
+		if priority.Id == model.Id {
+			tflog.Info(ctx, "success get priority")
+			return priority, nil
+		}
+	}
+
+	tflog.Info(ctx, "priority not found")
+	return *new(models.PriorityGetResponseModel), errors.New("failed to find priority " + model.Id)
+}
+
+func (p PriorityService) Create(ctx context.Context, model models.PriorityCreateRequestModel) (models.PriorityCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create priority w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPost, "/rest/api/2/priority", model)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.PriorityCreateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to create priority")
+		return *new(models.PriorityCreateResponseModel), err
+	}
+
+	result := models.PriorityCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.PriorityCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	if model.AfterPriorityId != "" || model.Position != "" {
+		err = p.move(ctx, result.Id, model.AfterPriorityId, model.Position)
+		if err != nil {
+			log.Println("failed to move created priority")
+			return result, err
+		}
+	}
+
+	tflog.Info(ctx, "success create priority")
+	return result, nil
+}
+
+func (p PriorityService) Update(ctx context.Context, model models.PriorityUpdateRequestModel) (models.PriorityUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update priority w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPut, "/rest/api/2/priority/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update priority")
+		return *new(models.PriorityUpdateResponseModel), err
+	}
+
+	if model.AfterPriorityId != "" || model.Position != "" {
+		err = p.move(ctx, model.Id, model.AfterPriorityId, model.Position)
+		if err != nil {
+			log.Println("failed to move updated priority")
+			return *new(models.PriorityUpdateResponseModel), err
+		}
+	}
+
+	tflog.Info(ctx, "success update priority")
+	return models.PriorityUpdateResponseModel{}, nil
+}
+
+func (p PriorityService) Delete(ctx context.Context, model models.PriorityDeleteRequestModel) (models.PriorityDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete priority w. data: %v", model))
+
+	path := "/rest/api/2/priority/" + url2.PathEscape(model.Id)
+	if model.ReplaceWith != "" {
+		path += "?replaceWith=" + url2.QueryEscape(model.ReplaceWith)
+	}
+	_, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodDelete, path, nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete priority")
+		return *new(models.PriorityDeleteResponseModel), err
+	}
+
+	log.Println("delete priority success")
+	return models.PriorityDeleteResponseModel{}, nil
+}
+
+// move puts the priority right after afterId, or at position "First" or "Last" of jira's ordering.
+func (p PriorityService) move(ctx context.Context, id string, afterId string, position string) error {
+	tflog.Info(ctx, "start move priority "+id+" after "+afterId+" to "+position)
+
+	_, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPut, "/rest/api/2/priority/move", models.PriorityMoveApiRequestModel{
+		Ids:      []string{id},
+		After:    afterId,
+		Position: position,
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to move priority")
+		return err
+	}
+
+	tflog.Info(ctx, "success move priority")
+	return nil
+}
@@ -0,0 +1,10 @@
+package models
+
+type PriorityCreateRequestModel struct {
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	StatusColor     string `json:"statusColor"`
+	IconUrl         string `json:"iconUrl,omitempty"`
+	AfterPriorityId string `json:"-"`
+	Position        string `json:"-"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type PriorityCreateResponseModel struct {
+	Id string `json:"id"`
+}
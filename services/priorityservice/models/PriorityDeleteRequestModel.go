@@ -0,0 +1,6 @@
+package models
+
+type PriorityDeleteRequestModel struct {
+	Id          string
+	ReplaceWith string
+}
@@ -0,0 +1,5 @@
+package models
+
+type PriorityGetRequestModel struct {
+	Id string
+}
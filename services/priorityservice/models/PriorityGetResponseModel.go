@@ -0,0 +1,12 @@
+package models
+
+type PriorityGetResponseModel struct {
+	Id              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	StatusColor     string `json:"statusColor"`
+	IconUrl         string `json:"iconUrl"`
+	Sequence        int64  `json:"-"`
+	AfterPriorityId string `json:"-"`
+	Last            bool   `json:"-"`
+}
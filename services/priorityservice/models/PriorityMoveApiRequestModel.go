@@ -0,0 +1,7 @@
+package models
+
+type PriorityMoveApiRequestModel struct {
+	Ids      []string `json:"ids"`
+	After    string   `json:"after,omitempty"`
+	Position string   `json:"position,omitempty"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type PriorityUpdateRequestModel struct {
+	Id              string `json:"-"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	StatusColor     string `json:"statusColor"`
+	IconUrl         string `json:"iconUrl,omitempty"`
+	AfterPriorityId string `json:"-"`
+	Position        string `json:"-"`
+}
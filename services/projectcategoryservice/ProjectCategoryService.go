@@ -0,0 +1,124 @@
+package projectcategoryservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectcategoryservice/models"
+)
+
+type IProjectCategoryService interface {
+	List(ctx context.Context, model models.ProjectCategoryListRequestModel) (models.ProjectCategoryListResponseModel, error)
+	Get(ctx context.Context, model models.ProjectCategoryGetRequestModel) (models.ProjectCategoryGetResponseModel, error)
+	Create(ctx context.Context, model models.ProjectCategoryCreateRequestModel) (models.ProjectCategoryCreateResponseModel, error)
+	Update(ctx context.Context, model models.ProjectCategoryUpdateRequestModel) (models.ProjectCategoryUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.ProjectCategoryDeleteRequestModel) (models.ProjectCategoryDeleteResponseModel, error)
+}
+
+type ProjectCategoryService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (p ProjectCategoryService) List(ctx context.Context, model models.ProjectCategoryListRequestModel) (models.ProjectCategoryListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list project categories w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/projectCategory", nil)
+	if err != nil {
+		log.Println("failed to list project categories")
+		return *new(models.ProjectCategoryListResponseModel), err
+	}
+
+	result := models.ProjectCategoryListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectCategoryListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list project categories")
+	return result, nil
+}
+
+func (p ProjectCategoryService) Get(ctx context.Context, model models.ProjectCategoryGetRequestModel) (models.ProjectCategoryGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get project category w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/projectCategory/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get project category")
+		return *new(models.ProjectCategoryGetResponseModel), err
+	}
+
+	result := models.ProjectCategoryGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectCategoryGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get project category")
+	return result, nil
+}
+
+func (p ProjectCategoryService) Create(ctx context.Context, model models.ProjectCategoryCreateRequestModel) (models.ProjectCategoryCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create project category w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPost, "/rest/api/2/projectCategory", model)
+	if err != nil {
+		log.Println("failed to create project category")
+		return *new(models.ProjectCategoryCreateResponseModel), err
+	}
+
+	result := models.ProjectCategoryCreateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectCategoryCreateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success create project category")
+	return result, nil
+}
+
+func (p ProjectCategoryService) Update(ctx context.Context, model models.ProjectCategoryUpdateRequestModel) (models.ProjectCategoryUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update project category w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPut, "/rest/api/2/projectCategory/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update project category")
+		return *new(models.ProjectCategoryUpdateResponseModel), err
+	}
+
+	result := models.ProjectCategoryUpdateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectCategoryUpdateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success update project category")
+	return result, nil
+}
+
+func (p ProjectCategoryService) Delete(ctx context.Context, model models.ProjectCategoryDeleteRequestModel) (models.ProjectCategoryDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete project category w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodDelete, "/rest/api/2/projectCategory/"+url2.PathEscape(model.Id), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete project category")
+		return *new(models.ProjectCategoryDeleteResponseModel), err
+	}
+
+	log.Println("delete project category success")
+	return models.ProjectCategoryDeleteResponseModel{}, nil
+}
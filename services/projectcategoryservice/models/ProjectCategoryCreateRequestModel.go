@@ -0,0 +1,6 @@
+package models
+
+type ProjectCategoryCreateRequestModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
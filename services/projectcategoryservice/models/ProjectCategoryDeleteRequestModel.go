@@ -0,0 +1,5 @@
+package models
+
+type ProjectCategoryDeleteRequestModel struct {
+	Id string
+}
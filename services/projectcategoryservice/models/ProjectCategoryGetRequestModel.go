@@ -0,0 +1,5 @@
+package models
+
+type ProjectCategoryGetRequestModel struct {
+	Id string
+}
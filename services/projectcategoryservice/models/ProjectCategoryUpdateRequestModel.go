@@ -0,0 +1,7 @@
+package models
+
+type ProjectCategoryUpdateRequestModel struct {
+	Id          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
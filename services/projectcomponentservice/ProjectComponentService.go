@@ -0,0 +1,107 @@
+package projectcomponentservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectcomponentservice/models"
+)
+
+type IProjectComponentService interface {
+	Get(ctx context.Context, model models.ProjectComponentGetRequestModel) (models.ProjectComponentGetResponseModel, error)
+	Create(ctx context.Context, model models.ProjectComponentCreateRequestModel) (models.ProjectComponentCreateResponseModel, error)
+	Update(ctx context.Context, model models.ProjectComponentUpdateRequestModel) (models.ProjectComponentUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.ProjectComponentDeleteRequestModel) (models.ProjectComponentDeleteResponseModel, error)
+}
+
+type ProjectComponentService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (p ProjectComponentService) Get(ctx context.Context, model models.ProjectComponentGetRequestModel) (models.ProjectComponentGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get project component w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/component/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get project component")
+		return *new(models.ProjectComponentGetResponseModel), err
+	}
+
+	result := models.ProjectComponentGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectComponentGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get project component")
+	return result, nil
+}
+
+func (p ProjectComponentService) Create(ctx context.Context, model models.ProjectComponentCreateRequestModel) (models.ProjectComponentCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create project component w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPost, "/rest/api/2/component", model)
+	if err != nil {
+		log.Println("failed to create project component")
+		return *new(models.ProjectComponentCreateResponseModel), err
+	}
+
+	result := models.ProjectComponentCreateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectComponentCreateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success create project component")
+	return result, nil
+}
+
+func (p ProjectComponentService) Update(ctx context.Context, model models.ProjectComponentUpdateRequestModel) (models.ProjectComponentUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update project component w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPut, "/rest/api/2/component/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update project component")
+		return *new(models.ProjectComponentUpdateResponseModel), err
+	}
+
+	result := models.ProjectComponentUpdateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectComponentUpdateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success update project component")
+	return result, nil
+}
+
+func (p ProjectComponentService) Delete(ctx context.Context, model models.ProjectComponentDeleteRequestModel) (models.ProjectComponentDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete project component w. data: %v", model))
+
+	path := "/rest/api/2/component/" + url2.PathEscape(model.Id)
+	if model.MoveIssuesTo != "" {
+		path += "?moveIssuesTo=" + url2.QueryEscape(model.MoveIssuesTo)
+	}
+	_, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodDelete, path, nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete project component")
+		return *new(models.ProjectComponentDeleteResponseModel), err
+	}
+
+	log.Println("delete project component success")
+	return models.ProjectComponentDeleteResponseModel{}, nil
+}
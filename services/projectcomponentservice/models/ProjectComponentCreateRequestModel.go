@@ -0,0 +1,9 @@
+package models
+
+type ProjectComponentCreateRequestModel struct {
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	LeadUserName string `json:"leadUserName"`
+	AssigneeType string `json:"assigneeType"`
+	Project      string `json:"project"`
+}
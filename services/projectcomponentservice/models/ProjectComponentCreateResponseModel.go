@@ -0,0 +1,6 @@
+package models
+
+type ProjectComponentCreateResponseModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
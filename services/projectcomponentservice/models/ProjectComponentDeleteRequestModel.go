@@ -0,0 +1,6 @@
+package models
+
+type ProjectComponentDeleteRequestModel struct {
+	Id           string
+	MoveIssuesTo string
+}
@@ -0,0 +1,5 @@
+package models
+
+type ProjectComponentGetRequestModel struct {
+	Id string
+}
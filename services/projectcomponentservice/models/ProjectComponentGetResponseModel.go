@@ -0,0 +1,11 @@
+package models
+
+type ProjectComponentGetResponseModel struct {
+	Id           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	LeadUserName string `json:"leadUserName"`
+	AssigneeType string `json:"assigneeType"`
+	Project      string `json:"project"`
+	ProjectId    int64  `json:"projectId"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type ProjectComponentUpdateRequestModel struct {
+	Id           string `json:"-"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	LeadUserName string `json:"leadUserName"`
+	AssigneeType string `json:"assigneeType"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type ProjectComponentUpdateResponseModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
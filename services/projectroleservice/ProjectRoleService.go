@@ -16,6 +16,8 @@ import (
 	"time"
 )
 
+var ErrRoleNotFound = errors.New("project role not found")
+
 type IProjectRoleService interface {
 	GetRole(ctx context.Context, model models.ProjectRoleGetRequestModel) (models.ProjectRoleGetResponseModel, error)
 	ListRoles(ctx context.Context, model models.ProjectRoleListRequestModel) (models.ProjectRoleListResponseModel, error)
@@ -29,7 +31,7 @@ type ProjectRoleService struct {
 }
 
 func (p ProjectRoleService) UpdateRole(ctx context.Context, model models.ProjectRoleUpdateRequestModel) (models.ProjectRoleUpdateResponseModel, error) {
-	log.Printf("start update role w. data: %s", model)
+	log.Printf("start update role w. data: %v", model)
 	role, err := p.GetRole(ctx, models.ProjectRoleGetRequestModel{
 		Id: model.Id,
 	})
@@ -88,30 +90,56 @@ func (p ProjectRoleService) UpdateRole(ctx context.Context, model models.Project
 }
 
 func (p ProjectRoleService) GetRole(ctx context.Context, model models.ProjectRoleGetRequestModel) (models.ProjectRoleGetResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start get role w. data: %s", model))
-	roles, err := p.ListRoles(ctx, models.ProjectRoleListRequestModel{})
+	tflog.Info(ctx, fmt.Sprintf("start get role w. data: %v", model))
+	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/role/" + strconv.FormatInt(model.Id, 10)
+	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
-		log.Println("failed to list roles")
-		return *new(models.ProjectRoleGetResponseModel), errors.New("failed to list roles")
+		tflog.Info(ctx, "error building http request")
+		return *new(models.ProjectRoleGetResponseModel), errors.New("error building http request")
 	}
+	req.Header.Set("Authorization", p.JiraServerBase.AuthorizationMethod+" "+p.JiraServerBase.Token)
+	req.Header.Set("Accept", "application/json")
 
-	foundRole := models.ProjectRoleGetResponseModel{}
-	for _, role := range roles {
-		if role.Id == model.Id {
-			foundRole = role
-		}
+	client := http.Client{
+		Timeout: time.Second * 30,
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		tflog.Info(ctx, err.Error())
+		tflog.Info(ctx, "http request failed")
+		return *new(models.ProjectRoleGetResponseModel), errors.New("http request returned error")
+	}
+	defer res.Body.Close()
+
+	tflog.Info(ctx, res.Status)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		tflog.Info(ctx, "read all body failed")
+		return *new(models.ProjectRoleGetResponseModel), errors.New("error reading response body")
 	}
-	if foundRole.Name == "" && foundRole.Description == "" {
+
+	tflog.Info(ctx, "response body: "+string(body))
+	if res.StatusCode == http.StatusNotFound {
 		log.Println("role not found")
-		return *new(models.ProjectRoleGetResponseModel), errors.New("failed to get role")
+		return *new(models.ProjectRoleGetResponseModel), ErrRoleNotFound
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return *new(models.ProjectRoleGetResponseModel), errors.New("get role returned " + res.Status + ": " + string(body))
+	}
+
+	result := models.ProjectRoleGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectRoleGetResponseModel), errors.New("error unmarshalling response body")
 	}
 
 	log.Println("success get role")
-	return foundRole, nil
+	return result, nil
 }
 
 func (p ProjectRoleService) ListRoles(ctx context.Context, model models.ProjectRoleListRequestModel) (models.ProjectRoleListResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("start list roles w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("start list roles w. data: %v", model))
 	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/role"
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -152,7 +180,7 @@ func (p ProjectRoleService) ListRoles(ctx context.Context, model models.ProjectR
 }
 
 func (p ProjectRoleService) CreateRole(ctx context.Context, model models.ProjectRoleCreateRequestModel) (models.ProjectRoleCreateResponseModel, error) {
-	tflog.Info(ctx, fmt.Sprintf("Start CreateRole w. data: %s", model))
+	tflog.Info(ctx, fmt.Sprintf("Start CreateRole w. data: %v", model))
 
 	serialized, err := json.Marshal(model)
 	if err != nil {
@@ -200,16 +228,13 @@ func (p ProjectRoleService) CreateRole(ctx context.Context, model models.Project
 }
 
 func (p ProjectRoleService) DeleteRole(ctx context.Context, model models.ProjectRoleDeleteRequestModel) (models.ProjectRoleDeleteResponseModel, error) {
-	log.Printf("start delete role w. data: %s", model)
-	role, err := p.GetRole(ctx, models.ProjectRoleGetRequestModel{
-		Id: model.Id,
-	})
-	if err != nil {
-		log.Println("error get role not found")
-		return *new(models.ProjectRoleDeleteResponseModel), errors.New("role for delete not found")
-	}
+	log.Printf("start delete role w. data: %v", model)
 
-	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/role/" + strconv.FormatInt(role.Id, 10)
+	// without swap jira refuses to delete a role that is still used by schemes
+	url := "https://" + p.JiraServerBase.Domain + "/rest/api/2/role/" + strconv.FormatInt(model.Id, 10)
+	if model.Swap != 0 {
+		url += "?swap=" + strconv.FormatInt(model.Swap, 10)
+	}
 	req, err := http.NewRequest(http.MethodDelete, url, nil)
 	if err != nil {
 		log.Println("error building http request")
@@ -228,6 +253,14 @@ func (p ProjectRoleService) DeleteRole(ctx context.Context, model models.Project
 	defer res.Body.Close()
 
 	log.Println(res.Status)
+	if res.StatusCode == http.StatusNotFound {
+		log.Println("role already deleted")
+		return models.ProjectRoleDeleteResponseModel{}, nil
+	}
+	if res.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(res.Body)
+		return *new(models.ProjectRoleDeleteResponseModel), errors.New("delete role returned " + res.Status + ": " + string(body))
+	}
 
 	log.Println("delete role success")
 	return models.ProjectRoleDeleteResponseModel{}, nil
@@ -1,5 +1,6 @@
 package models
 
 type ProjectRoleDeleteRequestModel struct {
-	Id int64
+	Id   int64
+	Swap int64
 }
@@ -0,0 +1,206 @@
+package projectschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/notificationschemeservice"
+	models3 "terraform-provider-hashicups-pf/services/notificationschemeservice/models"
+	"terraform-provider-hashicups-pf/services/projectschemeservice/models"
+)
+
+// scheme types name the /rest/api/2/project/{key}/{schemeType} endpoint reporting the attached scheme
+const (
+	SchemeTypePermission    = "permissionscheme"
+	SchemeTypeNotification  = "notificationscheme"
+	SchemeTypeIssueSecurity = "issuesecuritylevelscheme"
+)
+
+// DefaultPermissionSchemeId is the id the permission scheme endpoints accept for the default permission scheme
+const DefaultPermissionSchemeId = 0
+
+var ErrProjectNotFound = errors.New("project not found")
+
+// project update fields used for the scheme types that have no dedicated assignment endpoint
+var projectUpdateFields = map[string]string{
+	SchemeTypeNotification:  "notificationScheme",
+	SchemeTypeIssueSecurity: "issueSecurityScheme",
+}
+
+type IProjectSchemeService interface {
+	Get(ctx context.Context, model models.ProjectSchemeGetRequestModel) (models.ProjectSchemeGetResponseModel, error)
+	Set(ctx context.Context, model models.ProjectSchemeSetRequestModel) (models.ProjectSchemeSetResponseModel, error)
+	SetDefault(ctx context.Context, model models.ProjectSchemeSetDefaultRequestModel) (models.ProjectSchemeSetResponseModel, error)
+}
+
+type ProjectSchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s ProjectSchemeService) Get(ctx context.Context, model models.ProjectSchemeGetRequestModel) (models.ProjectSchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get project scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/project/"+url2.PathEscape(model.ProjectKey)+"/"+model.SchemeType, nil)
+	if errors.Is(err, baseservice.ErrNotFound) && model.SchemeType != SchemeTypePermission {
+		// projects without a notification or issue security scheme answer 404 as well
+		_, err = baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/project/"+url2.PathEscape(model.ProjectKey), nil)
+		if err == nil {
+			tflog.Info(ctx, "project has no "+model.SchemeType)
+			return models.ProjectSchemeGetResponseModel{}, nil
+		}
+	}
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.ProjectSchemeGetResponseModel), ErrProjectNotFound
+	}
+	if err != nil {
+		log.Println("failed to get project scheme")
+		return *new(models.ProjectSchemeGetResponseModel), err
+	}
+
+	result := models.ProjectSchemeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectSchemeGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get project scheme")
+	return result, nil
+}
+
+func (s ProjectSchemeService) Set(ctx context.Context, model models.ProjectSchemeSetRequestModel) (models.ProjectSchemeSetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start set project scheme w. data: %v", model))
+
+	path := "/rest/api/2/project/" + url2.PathEscape(model.ProjectKey)
+	var payload interface{} = map[string]int64{projectUpdateFields[model.SchemeType]: model.SchemeId}
+	if model.SchemeType == SchemeTypePermission {
+		path += "/" + SchemeTypePermission
+		payload = models.ProjectSchemeIdApiRequestModel{Id: model.SchemeId}
+	}
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, path, payload)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.ProjectSchemeSetResponseModel), ErrProjectNotFound
+	}
+	if err != nil {
+		log.Println("failed to set project scheme")
+		return *new(models.ProjectSchemeSetResponseModel), err
+	}
+
+	tflog.Info(ctx, "success set project scheme")
+	return models.ProjectSchemeSetResponseModel{}, nil
+}
+
+// SetDefault attaches the default scheme of the scheme type, for issue security the default is no scheme at all
+func (s ProjectSchemeService) SetDefault(ctx context.Context, model models.ProjectSchemeSetDefaultRequestModel) (models.ProjectSchemeSetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start set default project scheme w. data: %v", model))
+
+	switch model.SchemeType {
+	case SchemeTypePermission:
+		return s.Set(ctx, models.ProjectSchemeSetRequestModel{
+			ProjectKey: model.ProjectKey,
+			SchemeType: model.SchemeType,
+			SchemeId:   DefaultPermissionSchemeId,
+		})
+	case SchemeTypeNotification:
+		schemeId, err := s.getDefaultNotificationSchemeId(ctx)
+		if err != nil {
+			log.Println("failed to get default notification scheme")
+			return *new(models.ProjectSchemeSetResponseModel), err
+		}
+		return s.Set(ctx, models.ProjectSchemeSetRequestModel{
+			ProjectKey: model.ProjectKey,
+			SchemeType: model.SchemeType,
+			SchemeId:   schemeId,
+		})
+	case SchemeTypeIssueSecurity:
+		return s.detachIssueSecurityScheme(ctx, model.ProjectKey)
+	}
+	return *new(models.ProjectSchemeSetResponseModel), errors.New("unknown scheme type " + model.SchemeType)
+}
+
+// getDefaultNotificationSchemeId finds the scheme jira creates at setup, the rest api does not flag it so it is the oldest one
+func (s ProjectSchemeService) getDefaultNotificationSchemeId(ctx context.Context) (int64, error) {
+	notificationSchemeService := notificationschemeservice.NotificationSchemeService{
+		JiraServerBase: s.JiraServerBase,
+	}
+	schemes, err := notificationSchemeService.List(ctx, models3.NotificationSchemeListRequestModel{})
+	if err != nil {
+		return 0, err
+	}
+
+	var defaultSchemeId int64
+	for _, scheme := range schemes.NotificationSchemes {
+		if defaultSchemeId == 0 || scheme.Id < defaultSchemeId {
+			defaultSchemeId = scheme.Id
+		}
+	}
+	if defaultSchemeId == 0 {
+		return 0, errors.New("no notification scheme found to fall back to")
+	}
+	return defaultSchemeId, nil
+}
+
+// detachIssueSecurityScheme selects none on the project's issue security admin page, the project update api only switches schemes
+func (s ProjectSchemeService) detachIssueSecurityScheme(ctx context.Context, projectKey string) (models.ProjectSchemeSetResponseModel, error) {
+	current, err := s.Get(ctx, models.ProjectSchemeGetRequestModel{
+		ProjectKey: projectKey,
+		SchemeType: SchemeTypeIssueSecurity,
+	})
+	if err != nil {
+		return *new(models.ProjectSchemeSetResponseModel), err
+	}
+	if current.Id == 0 {
+		tflog.Info(ctx, "project has no issue security scheme")
+		return models.ProjectSchemeSetResponseModel{}, nil
+	}
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/project/"+url2.PathEscape(projectKey), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.ProjectSchemeSetResponseModel), ErrProjectNotFound
+	}
+	if err != nil {
+		log.Println("failed to get project")
+		return *new(models.ProjectSchemeSetResponseModel), err
+	}
+
+	project := models.ProjectSchemeProjectApiResponseModel{}
+	err = json.Unmarshal(body, &project)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectSchemeSetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	form := url2.Values{}
+	form.Set("projectId", project.Id)
+	form.Set("origSchemeId", strconv.FormatInt(current.Id, 10))
+	form.Set("newSchemeId", "-1")
+	_, err = baseservice.SendForm(ctx, s.JiraServerBase, http.MethodPost, "/secure/project/SelectProjectIssueSecuritySchemeStep2.jspa", form)
+	if err != nil {
+		log.Println("failed to detach issue security scheme")
+		return *new(models.ProjectSchemeSetResponseModel), err
+	}
+
+	// the admin page answers 200 on validation errors too, so the detach is checked by reading it back
+	found, err := s.Get(ctx, models.ProjectSchemeGetRequestModel{
+		ProjectKey: projectKey,
+		SchemeType: SchemeTypeIssueSecurity,
+	})
+	if err != nil {
+		return *new(models.ProjectSchemeSetResponseModel), err
+	}
+	if found.Id != 0 {
+		return *new(models.ProjectSchemeSetResponseModel), errors.New("jira did not remove the issue security scheme from project " + projectKey + ", the token needs jira administrator rights")
+	}
+
+	tflog.Info(ctx, "success detach issue security scheme")
+	return models.ProjectSchemeSetResponseModel{}, nil
+}
@@ -0,0 +1,6 @@
+package models
+
+type ProjectSchemeGetRequestModel struct {
+	ProjectKey string
+	SchemeType string
+}
@@ -0,0 +1,6 @@
+package models
+
+type ProjectSchemeGetResponseModel struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type ProjectSchemeSetDefaultRequestModel struct {
+	ProjectKey string
+	SchemeType string
+}
+
+type ProjectSchemeProjectApiResponseModel struct {
+	Id string `json:"id"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type ProjectSchemeSetRequestModel struct {
+	ProjectKey string
+	SchemeType string
+	SchemeId   int64
+}
+
+type ProjectSchemeIdApiRequestModel struct {
+	Id int64 `json:"id"`
+}
@@ -0,0 +1,136 @@
+package projectversionservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/projectversionservice/models"
+)
+
+type IProjectVersionService interface {
+	Get(ctx context.Context, model models.ProjectVersionGetRequestModel) (models.ProjectVersionGetResponseModel, error)
+	Create(ctx context.Context, model models.ProjectVersionCreateRequestModel) (models.ProjectVersionCreateResponseModel, error)
+	Update(ctx context.Context, model models.ProjectVersionUpdateRequestModel) (models.ProjectVersionUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.ProjectVersionDeleteRequestModel) (models.ProjectVersionDeleteResponseModel, error)
+}
+
+type ProjectVersionService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (p ProjectVersionService) Get(ctx context.Context, model models.ProjectVersionGetRequestModel) (models.ProjectVersionGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get project version w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/version/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get project version")
+		return *new(models.ProjectVersionGetResponseModel), err
+	}
+
+	result := models.ProjectVersionGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ProjectVersionGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// versions only report their project id, the resource is keyed by project key
+	result.ProjectKey, err = p.getProjectKey(ctx, result.ProjectId)
+	if err != nil {
+		log.Println("failed to get project of version")
+		return *new(models.ProjectVersionGetResponseModel), err
+	}
+
+	tflog.Info(ctx, "success get project version")
+	return result, nil
+}
+
+func (p ProjectVersionService) getProjectKey(ctx context.Context, projectId int64) (string, error) {
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodGet, "/rest/api/2/project/"+strconv.FormatInt(projectId, 10), nil)
+	if err != nil {
+		return "", err
+	}
+
+	project := models.ProjectVersionProjectApiResponseModel{}
+	err = json.Unmarshal(body, &project)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return "", errors.New("error unmarshalling response body")
+	}
+	return project.Key, nil
+}
+
+func (p ProjectVersionService) Create(ctx context.Context, model models.ProjectVersionCreateRequestModel) (models.ProjectVersionCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create project version w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPost, "/rest/api/2/version", model)
+	if err != nil {
+		log.Println("failed to create project version")
+		return *new(models.ProjectVersionCreateResponseModel), err
+	}
+
+	result := models.ProjectVersionCreateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectVersionCreateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success create project version")
+	return result, nil
+}
+
+func (p ProjectVersionService) Update(ctx context.Context, model models.ProjectVersionUpdateRequestModel) (models.ProjectVersionUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update project version w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, p.JiraServerBase, http.MethodPut, "/rest/api/2/version/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update project version")
+		return *new(models.ProjectVersionUpdateResponseModel), err
+	}
+
+	result := models.ProjectVersionUpdateResponseModel{}
+	if len(body) > 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return *new(models.ProjectVersionUpdateResponseModel), errors.New("error unmarshalling response body")
+		}
+	}
+
+	tflog.Info(ctx, "success update project version")
+	return result, nil
+}
+
+func (p ProjectVersionService) Delete(ctx context.Context, model models.ProjectVersionDeleteRequestModel) (models.ProjectVersionDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete project version w. data: %v", model))
+
+	// without a target jira drops the version from its issues, removeAndSwap moves them instead
+	path := "/rest/api/2/version/" + url2.PathEscape(model.Id)
+	var err error
+	if model.MoveIssuesTo != 0 {
+		_, err = baseservice.Send(ctx, p.JiraServerBase, http.MethodPost, path+"/removeAndSwap", models.ProjectVersionRemoveAndSwapApiRequestModel{
+			MoveFixIssuesTo:      model.MoveIssuesTo,
+			MoveAffectedIssuesTo: model.MoveIssuesTo,
+		})
+	} else {
+		_, err = baseservice.Send(ctx, p.JiraServerBase, http.MethodDelete, path, nil)
+	}
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete project version")
+		return *new(models.ProjectVersionDeleteResponseModel), err
+	}
+
+	log.Println("delete project version success")
+	return models.ProjectVersionDeleteResponseModel{}, nil
+}
@@ -0,0 +1,11 @@
+package models
+
+type ProjectVersionCreateRequestModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Project     string `json:"project"`
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+}
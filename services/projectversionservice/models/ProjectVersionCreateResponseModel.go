@@ -0,0 +1,6 @@
+package models
+
+type ProjectVersionCreateResponseModel struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,11 @@
+package models
+
+type ProjectVersionDeleteRequestModel struct {
+	Id           string `json:"-"`
+	MoveIssuesTo int64  `json:"-"`
+}
+
+type ProjectVersionRemoveAndSwapApiRequestModel struct {
+	MoveFixIssuesTo      int64 `json:"moveFixIssuesTo"`
+	MoveAffectedIssuesTo int64 `json:"moveAffectedIssuesTo"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type ProjectVersionGetRequestModel struct {
+	Id string
+}
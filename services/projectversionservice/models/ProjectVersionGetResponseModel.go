@@ -0,0 +1,13 @@
+package models
+
+type ProjectVersionGetResponseModel struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	StartDate   string `json:"startDate"`
+	ReleaseDate string `json:"releaseDate"`
+	Released    bool   `json:"released"`
+	Archived    bool   `json:"archived"`
+	ProjectId   int64  `json:"projectId"`
+	ProjectKey  string `json:"-"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type ProjectVersionProjectApiResponseModel struct {
+	Id  string `json:"id"`
+	Key string `json:"key"`
+}
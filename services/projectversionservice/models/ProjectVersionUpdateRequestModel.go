@@ -0,0 +1,11 @@
+package models
+
+type ProjectVersionUpdateRequestModel struct {
+	Id          string  `json:"-"`
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	StartDate   *string `json:"startDate"`
+	ReleaseDate *string `json:"releaseDate"`
+	Released    bool    `json:"released"`
+	Archived    bool    `json:"archived"`
+}
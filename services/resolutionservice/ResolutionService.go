@@ -0,0 +1,120 @@
+package resolutionservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/resolutionservice/models"
+)
+
+type IResolutionService interface {
+	List(ctx context.Context, model models.ResolutionListRequestModel) (models.ResolutionListResponseModel, error)
+	Get(ctx context.Context, model models.ResolutionGetRequestModel) (models.ResolutionGetResponseModel, error)
+	Create(ctx context.Context, model models.ResolutionCreateRequestModel) (models.ResolutionCreateResponseModel, error)
+	Update(ctx context.Context, model models.ResolutionUpdateRequestModel) (models.ResolutionUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.ResolutionDeleteRequestModel) (models.ResolutionDeleteResponseModel, error)
+}
+
+type ResolutionService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (r ResolutionService) List(ctx context.Context, model models.ResolutionListRequestModel) (models.ResolutionListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list resolutions w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, r.JiraServerBase, http.MethodGet, "/rest/api/2/resolution", nil)
+	if err != nil {
+		log.Println("failed to list resolutions")
+		return *new(models.ResolutionListResponseModel), err
+	}
+
+	result := models.ResolutionListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ResolutionListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list resolutions")
+	return result, nil
+}
+
+func (r ResolutionService) Get(ctx context.Context, model models.ResolutionGetRequestModel) (models.ResolutionGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get resolution w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, r.JiraServerBase, http.MethodGet, "/rest/api/2/resolution/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get resolution")
+		return *new(models.ResolutionGetResponseModel), err
+	}
+
+	result := models.ResolutionGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ResolutionGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get resolution")
+	return result, nil
+}
+
+func (r ResolutionService) Create(ctx context.Context, model models.ResolutionCreateRequestModel) (models.ResolutionCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create resolution w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, r.JiraServerBase, http.MethodPost, "/rest/api/2/resolution", model)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.ResolutionCreateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to create resolution")
+		return *new(models.ResolutionCreateResponseModel), err
+	}
+
+	result := models.ResolutionCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.ResolutionCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create resolution")
+	return result, nil
+}
+
+func (r ResolutionService) Update(ctx context.Context, model models.ResolutionUpdateRequestModel) (models.ResolutionUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update resolution w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, r.JiraServerBase, http.MethodPut, "/rest/api/2/resolution/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update resolution")
+		return *new(models.ResolutionUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update resolution")
+	return models.ResolutionUpdateResponseModel{}, nil
+}
+
+func (r ResolutionService) Delete(ctx context.Context, model models.ResolutionDeleteRequestModel) (models.ResolutionDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete resolution w. data: %v", model))
+
+	path := "/rest/api/2/resolution/" + url2.PathEscape(model.Id)
+	if model.ReplaceWith != "" {
+		path += "?replaceWith=" + url2.QueryEscape(model.ReplaceWith)
+	}
+	_, err := baseservice.Send(ctx, r.JiraServerBase, http.MethodDelete, path, nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete resolution")
+		return *new(models.ResolutionDeleteResponseModel), err
+	}
+
+	log.Println("delete resolution success")
+	return models.ResolutionDeleteResponseModel{}, nil
+}
@@ -0,0 +1,5 @@
+package models
+
+type ResolutionCreateResponseModel struct {
+	Id string `json:"id"`
+}
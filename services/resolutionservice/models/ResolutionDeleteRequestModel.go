@@ -0,0 +1,6 @@
+package models
+
+type ResolutionDeleteRequestModel struct {
+	Id          string
+	ReplaceWith string
+}
@@ -0,0 +1,5 @@
+package models
+
+type ResolutionGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,7 @@
+package models
+
+type ResolutionGetResponseModel struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
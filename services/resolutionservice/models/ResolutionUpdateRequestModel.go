@@ -0,0 +1,7 @@
+package models
+
+type ResolutionUpdateRequestModel struct {
+	Id          string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
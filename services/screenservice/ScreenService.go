@@ -29,7 +29,7 @@ type ScreenService struct {
 }
 
 func (s ScreenService) Get(ctx context.Context, model models.ScreenGetRequestModel) (models.ScreenGetResponseModel, error) {
-	log.Printf("start get screen w. data %s", model)
+	log.Printf("start get screen w. data %v", model)
 
 	screens, err := s.List(ctx, models.ScreenListRequestModel{
 		Name: model.Name,
@@ -51,7 +51,7 @@ func (s ScreenService) Get(ctx context.Context, model models.ScreenGetRequestMod
 }
 
 func (s ScreenService) List(ctx context.Context, model models.ScreenListRequestModel) (models.ScreenListResponseModel, error) {
-	log.Printf("start list screen w. data %s", model)
+	log.Printf("start list screen w. data %v", model)
 
 	url := "https://" + s.JiraServerBase.Domain + "/rest/api/2/screens?querystring=" + url2.QueryEscape(model.Name)
 	req, err := http.NewRequest(http.MethodGet, url, nil)
@@ -93,7 +93,7 @@ func (s ScreenService) List(ctx context.Context, model models.ScreenListRequestM
 }
 
 func (s ScreenService) Create(ctx context.Context, model models.ScreenCreateRequestModel) (models.ScreenCreateResponseModel, error) {
-	log.Printf("start create screen w. data %s", model)
+	log.Printf("start create screen w. data %v", model)
 
 	url := "https://" + s.JiraServerBase.Domain + "/rest/api/2/screens"
 	log.Println(url)
@@ -142,7 +142,7 @@ func (s ScreenService) Create(ctx context.Context, model models.ScreenCreateRequ
 }
 
 func (s ScreenService) Update(ctx context.Context, model models.ScreenUpdateRequestModel) (models.ScreenUpdateResponseModel, error) {
-	log.Printf("start update screen w. data %s", model)
+	log.Printf("start update screen w. data %v", model)
 
 	foundScreen, err := s.Get(ctx, models.ScreenGetRequestModel{
 		Name: model.Name,
@@ -199,7 +199,7 @@ func (s ScreenService) Update(ctx context.Context, model models.ScreenUpdateRequ
 }
 
 func (s ScreenService) Delete(ctx context.Context, model models.ScreenDeleteRequestModel) (models.ScreenDeleteResponseModel, error) {
-	log.Printf("start delete screen w. data %s", model)
+	log.Printf("start delete screen w. data %v", model)
 
 	foundScreen, err := s.Get(ctx, models.ScreenGetRequestModel{
 		Name: model.Name,
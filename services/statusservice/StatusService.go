@@ -0,0 +1,144 @@
+package statusservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/statusservice/models"
+)
+
+// Categories accepted when creating or updating a status, jira reports them back by key.
+var Categories = map[string]string{
+	"new":           "TODO",
+	"indeterminate": "IN_PROGRESS",
+	"done":          "DONE",
+}
+
+type IStatusService interface {
+	List(ctx context.Context, model models.StatusListRequestModel) (models.StatusListResponseModel, error)
+	Get(ctx context.Context, model models.StatusGetRequestModel) (models.StatusGetResponseModel, error)
+	Create(ctx context.Context, model models.StatusCreateRequestModel) (models.StatusCreateResponseModel, error)
+	Update(ctx context.Context, model models.StatusUpdateRequestModel) (models.StatusUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.StatusDeleteRequestModel) (models.StatusDeleteResponseModel, error)
+}
+
+type StatusService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s StatusService) List(ctx context.Context, model models.StatusListRequestModel) (models.StatusListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list statuses w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/status", nil)
+	if err != nil {
+		log.Println("failed to list statuses")
+		return *new(models.StatusListResponseModel), err
+	}
+
+	result := models.StatusListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.StatusListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list statuses")
+	return result, nil
+}
+
+func (s StatusService) Get(ctx context.Context, model models.StatusGetRequestModel) (models.StatusGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get status w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/api/2/status/"+url2.PathEscape(model.Id), nil)
+	if err != nil {
+		log.Println("failed to get status")
+		return *new(models.StatusGetResponseModel), err
+	}
+
+	result := models.StatusGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.StatusGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get status")
+	return result, nil
+}
+
+func (s StatusService) Create(ctx context.Context, model models.StatusCreateRequestModel) (models.StatusCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create status w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPost, "/rest/api/2/statuses", models.StatusCreateApiRequestModel{
+		Scope: models.StatusScopeApiModel{
+			Type: "GLOBAL",
+		},
+		Statuses: []models.StatusCreateRequestModel{model},
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.StatusCreateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to create status")
+		return *new(models.StatusCreateResponseModel), err
+	}
+
+	result := []models.StatusCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil || len(result) == 0 {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.StatusCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create status")
+	return result[0], nil
+}
+
+func (s StatusService) Update(ctx context.Context, model models.StatusUpdateRequestModel) (models.StatusUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update status w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/api/2/statuses", models.StatusUpdateApiRequestModel{
+		Statuses: []models.StatusUpdateRequestModel{model},
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.StatusUpdateResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to update status")
+		return *new(models.StatusUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update status")
+	return models.StatusUpdateResponseModel{}, nil
+}
+
+func (s StatusService) Delete(ctx context.Context, model models.StatusDeleteRequestModel) (models.StatusDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete status w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, "/rest/api/2/statuses?id="+url2.QueryEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return *new(models.StatusDeleteResponseModel), baseservice.ErrNotSupported
+	}
+	if err != nil {
+		log.Println("failed to delete status")
+		return *new(models.StatusDeleteResponseModel), errors.New(err.Error() + ", a status used by workflows cannot be deleted")
+	}
+
+	log.Println("delete status success")
+	return models.StatusDeleteResponseModel{}, nil
+}
+
+func (s StatusService) CheckSupport(ctx context.Context) error {
+	err := baseservice.CheckEndpoint(ctx, s.JiraServerBase, "/rest/api/2/statuses/search?maxResults=1")
+	if errors.Is(err, baseservice.ErrNotSupported) {
+		return fmt.Errorf("managing statuses needs the bulk status rest endpoints: %w", err)
+	}
+	return err
+}
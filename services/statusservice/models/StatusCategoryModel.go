@@ -0,0 +1,7 @@
+package models
+
+type StatusCategoryModel struct {
+	Id   int64  `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
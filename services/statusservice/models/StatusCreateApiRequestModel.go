@@ -0,0 +1,6 @@
+package models
+
+type StatusCreateApiRequestModel struct {
+	Scope    StatusScopeApiModel        `json:"scope"`
+	Statuses []StatusCreateRequestModel `json:"statuses"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type StatusCreateRequestModel struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	StatusCategory string `json:"statusCategory"`
+}
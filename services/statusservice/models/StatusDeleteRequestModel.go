@@ -0,0 +1,5 @@
+package models
+
+type StatusDeleteRequestModel struct {
+	Id string
+}
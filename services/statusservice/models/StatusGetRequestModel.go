@@ -0,0 +1,5 @@
+package models
+
+type StatusGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,8 @@
+package models
+
+type StatusGetResponseModel struct {
+	Id             string              `json:"id"`
+	Name           string              `json:"name"`
+	Description    string              `json:"description"`
+	StatusCategory StatusCategoryModel `json:"statusCategory"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type StatusScopeApiModel struct {
+	Type string `json:"type"`
+}
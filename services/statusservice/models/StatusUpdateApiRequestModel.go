@@ -0,0 +1,5 @@
+package models
+
+type StatusUpdateApiRequestModel struct {
+	Statuses []StatusUpdateRequestModel `json:"statuses"`
+}
@@ -0,0 +1,8 @@
+package models
+
+type StatusUpdateRequestModel struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	StatusCategory string `json:"statusCategory"`
+}
@@ -0,0 +1,106 @@
+package webhookservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"path"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/webhookservice/models"
+)
+
+type IWebhookService interface {
+	Get(ctx context.Context, model models.WebhookGetRequestModel) (models.WebhookGetResponseModel, error)
+	Create(ctx context.Context, model models.WebhookCreateRequestModel) (models.WebhookCreateResponseModel, error)
+	Update(ctx context.Context, model models.WebhookUpdateRequestModel) (models.WebhookUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.WebhookDeleteRequestModel) (models.WebhookDeleteResponseModel, error)
+}
+
+type WebhookService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (s WebhookService) Get(ctx context.Context, model models.WebhookGetRequestModel) (models.WebhookGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get webhook w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodGet, "/rest/webhooks/1.0/webhook/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "webhook not found")
+		return *new(models.WebhookGetResponseModel), errors.New("failed to find webhook " + model.Id)
+	}
+	if err != nil {
+		log.Println("failed to get webhook")
+		return *new(models.WebhookGetResponseModel), err
+	}
+
+	result := models.WebhookGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WebhookGetResponseModel), errors.New("error unmarshalling response body")
+	}
+	result.Id = model.Id
+
+	tflog.Info(ctx, "success get webhook")
+	return result, nil
+}
+
+func (s WebhookService) Create(ctx context.Context, model models.WebhookCreateRequestModel) (models.WebhookCreateResponseModel, error) {
+	// only the name is logged so the secret never ends up in provider logs
+	tflog.Info(ctx, fmt.Sprintf("start create webhook w. data: %v", model.Name))
+
+	body, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPost, "/rest/webhooks/1.0/webhook", model)
+	if err != nil {
+		log.Println("failed to create webhook")
+		return *new(models.WebhookCreateResponseModel), err
+	}
+
+	result := models.WebhookCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WebhookCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	// the webhook api only reports the id as the last segment of its self link
+	result.Id = path.Base(result.Self)
+
+	tflog.Info(ctx, "success create webhook")
+	return result, nil
+}
+
+func (s WebhookService) Update(ctx context.Context, model models.WebhookUpdateRequestModel) (models.WebhookUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update webhook w. data: %v", model.Id))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodPut, "/rest/webhooks/1.0/webhook/"+url2.PathEscape(model.Id), model)
+	if err != nil {
+		log.Println("failed to update webhook")
+		return *new(models.WebhookUpdateResponseModel), err
+	}
+
+	tflog.Info(ctx, "success update webhook")
+	return models.WebhookUpdateResponseModel{}, nil
+}
+
+func (s WebhookService) Delete(ctx context.Context, model models.WebhookDeleteRequestModel) (models.WebhookDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete webhook w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, s.JiraServerBase, http.MethodDelete, "/rest/webhooks/1.0/webhook/"+url2.PathEscape(model.Id), nil)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		tflog.Info(ctx, "webhook already deleted")
+		return models.WebhookDeleteResponseModel{}, nil
+	}
+	if err != nil {
+		log.Println("failed to delete webhook")
+		return *new(models.WebhookDeleteResponseModel), err
+	}
+
+	tflog.Info(ctx, "success delete webhook")
+	return models.WebhookDeleteResponseModel{}, nil
+}
@@ -0,0 +1,11 @@
+package models
+
+type WebhookCreateRequestModel struct {
+	Name        string              `json:"name"`
+	Url         string              `json:"url"`
+	Events      []string            `json:"events"`
+	Filters     WebhookFiltersModel `json:"filters"`
+	ExcludeBody bool                `json:"excludeBody"`
+	Enabled     bool                `json:"enabled"`
+	Secret      string              `json:"secret,omitempty"`
+}
@@ -0,0 +1,6 @@
+package models
+
+type WebhookCreateResponseModel struct {
+	Id   string `json:"-"`
+	Self string `json:"self"`
+}
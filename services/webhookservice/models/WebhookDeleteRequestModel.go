@@ -0,0 +1,5 @@
+package models
+
+type WebhookDeleteRequestModel struct {
+	Id string
+}
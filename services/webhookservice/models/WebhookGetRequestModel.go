@@ -0,0 +1,5 @@
+package models
+
+type WebhookGetRequestModel struct {
+	Id string
+}
@@ -0,0 +1,16 @@
+package models
+
+type WebhookGetResponseModel struct {
+	Id          string              `json:"-"`
+	Self        string              `json:"self"`
+	Name        string              `json:"name"`
+	Url         string              `json:"url"`
+	Events      []string            `json:"events"`
+	Filters     WebhookFiltersModel `json:"filters"`
+	ExcludeBody bool                `json:"excludeBody"`
+	Enabled     bool                `json:"enabled"`
+}
+
+type WebhookFiltersModel struct {
+	IssueRelatedEventsSection string `json:"issue-related-events-section"`
+}
@@ -0,0 +1,12 @@
+package models
+
+type WebhookUpdateRequestModel struct {
+	Id          string              `json:"-"`
+	Name        string              `json:"name"`
+	Url         string              `json:"url"`
+	Events      []string            `json:"events"`
+	Filters     WebhookFiltersModel `json:"filters"`
+	ExcludeBody bool                `json:"excludeBody"`
+	Enabled     bool                `json:"enabled"`
+	Secret      *string             `json:"secret,omitempty"`
+}
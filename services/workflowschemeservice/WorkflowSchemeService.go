@@ -0,0 +1,191 @@
+package workflowschemeservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"log"
+	"net/http"
+	"strconv"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/workflowschemeservice/models"
+	"time"
+)
+
+// DefaultMigrationTimeout bounds how long Update waits on the migration task started by publishing a draft.
+const DefaultMigrationTimeout = time.Minute * 10
+
+type IWorkflowSchemeService interface {
+	Get(ctx context.Context, model models.WorkflowSchemeGetRequestModel) (models.WorkflowSchemeGetResponseModel, error)
+	Create(ctx context.Context, model models.WorkflowSchemeCreateRequestModel) (models.WorkflowSchemeCreateResponseModel, error)
+	Update(ctx context.Context, model models.WorkflowSchemeUpdateRequestModel) (models.WorkflowSchemeUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.WorkflowSchemeDeleteRequestModel) (models.WorkflowSchemeDeleteResponseModel, error)
+}
+
+type WorkflowSchemeService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (w WorkflowSchemeService) Get(ctx context.Context, model models.WorkflowSchemeGetRequestModel) (models.WorkflowSchemeGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get workflow scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodGet, "/rest/api/2/workflowscheme/"+strconv.FormatInt(model.Id, 10)+"?returnDraftIfExists=false", nil)
+	if err != nil {
+		log.Println("failed to get workflow scheme")
+		return *new(models.WorkflowSchemeGetResponseModel), err
+	}
+
+	result := models.WorkflowSchemeGetResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WorkflowSchemeGetResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success get workflow scheme")
+	return result, nil
+}
+
+func (w WorkflowSchemeService) Create(ctx context.Context, model models.WorkflowSchemeCreateRequestModel) (models.WorkflowSchemeCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create workflow scheme w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodPost, "/rest/api/2/workflowscheme", model)
+	if err != nil {
+		log.Println("failed to create workflow scheme")
+		return *new(models.WorkflowSchemeCreateResponseModel), err
+	}
+
+	result := models.WorkflowSchemeCreateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WorkflowSchemeCreateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success create workflow scheme")
+	return result, nil
+}
+
+func (w WorkflowSchemeService) Update(ctx context.Context, model models.WorkflowSchemeUpdateRequestModel) (models.WorkflowSchemeUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update workflow scheme w. data: %v", model))
+
+	// schemes used by projects cannot be changed directly, jira writes the change into a draft instead
+	model.UpdateDraftIfNeeded = true
+	body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodPut, "/rest/api/2/workflowscheme/"+strconv.FormatInt(model.Id, 10), model)
+	if err != nil {
+		log.Println("failed to update workflow scheme")
+		return *new(models.WorkflowSchemeUpdateResponseModel), err
+	}
+
+	result := models.WorkflowSchemeUpdateResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WorkflowSchemeUpdateResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	if result.Draft {
+		err = w.publishDraft(ctx, model)
+		if err != nil {
+			log.Println("failed to publish workflow scheme draft")
+			return *new(models.WorkflowSchemeUpdateResponseModel), err
+		}
+		result.Draft = false
+	}
+
+	tflog.Info(ctx, "success update workflow scheme")
+	return result, nil
+}
+
+func (w WorkflowSchemeService) Delete(ctx context.Context, model models.WorkflowSchemeDeleteRequestModel) (models.WorkflowSchemeDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete workflow scheme w. data: %v", model))
+
+	_, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodDelete, "/rest/api/2/workflowscheme/"+strconv.FormatInt(model.Id, 10), nil)
+	if err != nil && !errors.Is(err, baseservice.ErrNotFound) {
+		log.Println("failed to delete workflow scheme")
+		return *new(models.WorkflowSchemeDeleteResponseModel), errors.New(err.Error() + ", a scheme still used by projects cannot be deleted")
+	}
+
+	log.Println("delete workflow scheme success")
+	return models.WorkflowSchemeDeleteResponseModel{}, nil
+}
+
+func (w WorkflowSchemeService) publishDraft(ctx context.Context, model models.WorkflowSchemeUpdateRequestModel) error {
+	tflog.Info(ctx, fmt.Sprintf("start publish workflow scheme draft w. id: %d", model.Id))
+
+	statusMappings := model.StatusMappings
+	if statusMappings == nil {
+		statusMappings = []models.WorkflowSchemeStatusMappingModel{}
+	}
+
+	// jira answers with 303 pointing at the migration task, following it returns the task itself
+	body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodPost, "/rest/api/2/workflowscheme/"+strconv.FormatInt(model.Id, 10)+"/draft/publish", models.WorkflowSchemePublishApiRequestModel{
+		StatusMappings: statusMappings,
+	})
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return errors.New("publishing workflow scheme drafts is not supported on this jira server, publish the draft of scheme " + strconv.FormatInt(model.Id, 10) + " from the administration ui")
+	}
+	if err != nil {
+		return errors.New(err.Error() + ", status_mapping may be missing for statuses that do not exist in the new workflows")
+	}
+	if len(body) == 0 {
+		tflog.Info(ctx, "success publish workflow scheme draft")
+		return nil
+	}
+
+	task := models.WorkflowSchemeTaskProgressModel{}
+	err = json.Unmarshal(body, &task)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return errors.New("error unmarshalling response body")
+	}
+	if task.Id == "" {
+		tflog.Info(ctx, "success publish workflow scheme draft")
+		return nil
+	}
+	return w.waitForTask(ctx, task, model.MigrationTimeout)
+}
+
+func (w WorkflowSchemeService) waitForTask(ctx context.Context, task models.WorkflowSchemeTaskProgressModel, timeout time.Duration) error {
+	tflog.Info(ctx, "start wait for workflow scheme migration task w. id: "+task.Id)
+
+	if timeout <= 0 {
+		timeout = DefaultMigrationTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		tflog.Info(ctx, fmt.Sprintf("migration task %s is %s (%d%%)", task.Id, task.Status, task.Progress))
+		switch task.Status {
+		case "COMPLETE":
+			tflog.Info(ctx, "success wait for workflow scheme migration task")
+			return nil
+		case "FAILED", "CANCELLED", "DEAD":
+			return errors.New("workflow scheme migration task " + task.Id + " ended as " + task.Status + ": " + task.Message)
+		}
+
+		if time.Now().After(deadline) {
+			return errors.New("timed out waiting for workflow scheme migration task " + task.Id + ", it keeps running in jira")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second * 5):
+		}
+
+		body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodGet, "/rest/api/2/task/"+task.Id, nil)
+		if err != nil {
+			log.Println("failed to get migration task")
+			return err
+		}
+
+		task = models.WorkflowSchemeTaskProgressModel{}
+		err = json.Unmarshal(body, &task)
+		if err != nil {
+			tflog.Info(ctx, "failed to unmarshal response body")
+			return errors.New("error unmarshalling response body")
+		}
+	}
+}
@@ -0,0 +1,8 @@
+package models
+
+type WorkflowSchemeCreateRequestModel struct {
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	DefaultWorkflow   string            `json:"defaultWorkflow,omitempty"`
+	IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+}
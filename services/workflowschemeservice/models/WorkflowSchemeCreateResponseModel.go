@@ -0,0 +1,9 @@
+package models
+
+type WorkflowSchemeCreateResponseModel struct {
+	Id                int64             `json:"id"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	DefaultWorkflow   string            `json:"defaultWorkflow"`
+	IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+}
@@ -0,0 +1,5 @@
+package models
+
+type WorkflowSchemeDeleteRequestModel struct {
+	Id int64
+}
@@ -0,0 +1,5 @@
+package models
+
+type WorkflowSchemeGetRequestModel struct {
+	Id int64
+}
@@ -0,0 +1,5 @@
+package models
+
+type WorkflowSchemePublishApiRequestModel struct {
+	StatusMappings []WorkflowSchemeStatusMappingModel `json:"statusMappings"`
+}
@@ -0,0 +1,7 @@
+package models
+
+type WorkflowSchemeStatusMappingModel struct {
+	IssueTypeId string `json:"issueTypeId"`
+	StatusId    string `json:"statusId"`
+	NewStatusId string `json:"newStatusId"`
+}
@@ -0,0 +1,9 @@
+package models
+
+type WorkflowSchemeTaskProgressModel struct {
+	Self     string `json:"self"`
+	Id       string `json:"id"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	Progress int64  `json:"progress"`
+}
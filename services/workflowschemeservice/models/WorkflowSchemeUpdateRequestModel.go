@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+type WorkflowSchemeUpdateRequestModel struct {
+	Id                  int64                              `json:"-"`
+	Name                string                             `json:"name"`
+	Description         string                             `json:"description"`
+	DefaultWorkflow     string                             `json:"defaultWorkflow,omitempty"`
+	IssueTypeMappings   map[string]string                  `json:"issueTypeMappings"`
+	UpdateDraftIfNeeded bool                               `json:"updateDraftIfNeeded"`
+	StatusMappings      []WorkflowSchemeStatusMappingModel `json:"-"`
+	MigrationTimeout    time.Duration                      `json:"-"`
+}
@@ -0,0 +1,10 @@
+package models
+
+type WorkflowSchemeUpdateResponseModel struct {
+	Id                int64             `json:"id"`
+	Name              string            `json:"name"`
+	Description       string            `json:"description"`
+	DefaultWorkflow   string            `json:"defaultWorkflow"`
+	IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+	Draft             bool              `json:"draft"`
+}
@@ -0,0 +1,89 @@
+package workflowservice
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+)
+
+// NormalizeDefinition strips everything jira rewrites on export (doctype, comments, whitespace,
+// attribute order and the jira.update* meta entries) so an imported file and its export compare equal.
+func NormalizeDefinition(definition string) (string, error) {
+	decoder := xml.NewDecoder(strings.NewReader(definition))
+	decoder.Strict = false
+
+	var out bytes.Buffer
+	encoder := xml.NewEncoder(&out)
+	skipDepth := 0
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", errors.New("invalid workflow xml: " + err.Error())
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if skipDepth > 0 || isVolatileMeta(t) {
+				skipDepth++
+				continue
+			}
+			sort.Slice(t.Attr, func(a, b int) bool {
+				return t.Attr[a].Name.Local < t.Attr[b].Name.Local
+			})
+			err = encoder.EncodeToken(t.Copy())
+		case xml.EndElement:
+			if skipDepth > 0 {
+				skipDepth--
+				continue
+			}
+			err = encoder.EncodeToken(t)
+		case xml.CharData:
+			if skipDepth > 0 {
+				continue
+			}
+			trimmed := strings.TrimSpace(string(t))
+			if trimmed == "" {
+				continue
+			}
+			err = encoder.EncodeToken(xml.CharData(trimmed))
+		}
+		if err != nil {
+			return "", errors.New("failed to normalize workflow xml: " + err.Error())
+		}
+	}
+
+	if err := encoder.Flush(); err != nil {
+		return "", errors.New("failed to normalize workflow xml: " + err.Error())
+	}
+	return out.String(), nil
+}
+
+// DefinitionHash returns the sha256 of the normalized workflow definition.
+func DefinitionHash(definition string) (string, error) {
+	normalized, err := NormalizeDefinition(definition)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func isVolatileMeta(element xml.StartElement) bool {
+	if element.Name.Local != "meta" {
+		return false
+	}
+	for _, attr := range element.Attr {
+		if attr.Name.Local == "name" && strings.HasPrefix(attr.Value, "jira.update") {
+			return true
+		}
+	}
+	return false
+}
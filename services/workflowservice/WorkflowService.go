@@ -0,0 +1,382 @@
+package workflowservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"html"
+	"log"
+	"net/http"
+	url2 "net/url"
+	"regexp"
+	"strings"
+	"terraform-provider-hashicups-pf/services/baseservice"
+	models2 "terraform-provider-hashicups-pf/services/baseservice/models"
+	"terraform-provider-hashicups-pf/services/workflowservice/models"
+)
+
+// updates import the new definition under importSuffix and keep the live one under backupSuffix
+// until the swap is done, jira server cannot load xml into an existing workflow.
+const (
+	importSuffix = " (terraform import)"
+	backupSuffix = " (terraform backup)"
+)
+
+// the workflow admin page lists active and inactive workflows in two tables
+const (
+	activeWorkflowsMarker   = `id="active-workflows-table"`
+	inactiveWorkflowsMarker = `id="inactive-workflows-table"`
+)
+
+// the schemes column of the workflow admin page links every workflow scheme using the workflow
+var schemeLinkPattern = regexp.MustCompile(`schemeId=\d+`)
+
+type IWorkflowService interface {
+	List(ctx context.Context, model models.WorkflowListRequestModel) (models.WorkflowListResponseModel, error)
+	Get(ctx context.Context, model models.WorkflowGetRequestModel) (models.WorkflowGetResponseModel, error)
+	Create(ctx context.Context, model models.WorkflowCreateRequestModel) (models.WorkflowCreateResponseModel, error)
+	Update(ctx context.Context, model models.WorkflowUpdateRequestModel) (models.WorkflowUpdateResponseModel, error)
+	Delete(ctx context.Context, model models.WorkflowDeleteRequestModel) (models.WorkflowDeleteResponseModel, error)
+}
+
+type WorkflowService struct {
+	JiraServerBase models2.JiraServerBase `json:"jiraServerBase"`
+}
+
+func (w WorkflowService) List(ctx context.Context, model models.WorkflowListRequestModel) (models.WorkflowListResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start list workflows w. data: %v", model))
+
+	body, err := baseservice.Send(ctx, w.JiraServerBase, http.MethodGet, "/rest/api/2/workflow", nil)
+	if err != nil {
+		log.Println("failed to list workflows")
+		return *new(models.WorkflowListResponseModel), err
+	}
+
+	result := models.WorkflowListResponseModel{}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		tflog.Info(ctx, "failed to unmarshal response body")
+		return *new(models.WorkflowListResponseModel), errors.New("error unmarshalling response body")
+	}
+
+	tflog.Info(ctx, "success list workflows")
+	return result, nil
+}
+
+func (w WorkflowService) Get(ctx context.Context, model models.WorkflowGetRequestModel) (models.WorkflowGetResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start get workflow w. data: %v", model))
+
+	workflows, err := w.List(ctx, models.WorkflowListRequestModel{})
+	if err != nil {
+		log.Println("failed to list workflows")
+		return *new(models.WorkflowGetResponseModel), err
+	}
+
+	foundWorkflow := models.WorkflowGetResponseModel{}
+	for _, workflow := range workflows {
+		if workflow.Name == model.Name {
+			foundWorkflow = workflow
+			break
+		}
+	}
+	if foundWorkflow.Name == "" {
+		tflog.Info(ctx, "workflow not found")
+		return *new(models.WorkflowGetResponseModel), errors.New("failed to find workflow " + model.Name)
+	}
+
+	definition, err := w.export(ctx, model.Name, "live")
+	if err != nil {
+		return *new(models.WorkflowGetResponseModel), err
+	}
+
+	foundWorkflow.Definition = definition
+	tflog.Info(ctx, "success get workflow")
+	return foundWorkflow, nil
+}
+
+func (w WorkflowService) Create(ctx context.Context, model models.WorkflowCreateRequestModel) (models.WorkflowCreateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start create workflow w. name: %s", model.Name))
+
+	form := url2.Values{}
+	form.Set("name", model.Name)
+	form.Set("description", model.Description)
+	form.Set("workflowXML", model.Definition)
+
+	_, err := baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/ImportWorkflowFromXml.jspa", form)
+	if err != nil {
+		log.Println("failed to import workflow")
+		return *new(models.WorkflowCreateResponseModel), err
+	}
+
+	// the admin action answers with an html page either way, check the workflow actually exists
+	_, err = w.Get(ctx, models.WorkflowGetRequestModel{
+		Name: model.Name,
+	})
+	if err != nil {
+		log.Println("imported workflow not found")
+		return *new(models.WorkflowCreateResponseModel), errors.New("jira did not import workflow " + model.Name + ", check the xml definition and that the name is unused")
+	}
+
+	tflog.Info(ctx, "success create workflow")
+	return models.WorkflowCreateResponseModel{
+		Name: model.Name,
+	}, nil
+}
+
+func (w WorkflowService) Update(ctx context.Context, model models.WorkflowUpdateRequestModel) (models.WorkflowUpdateResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start update workflow w. name: %s", model.Name))
+
+	row, active, err := w.findRow(ctx, model.Name)
+	if err != nil {
+		log.Println("failed to check whether workflow is active")
+		return *new(models.WorkflowUpdateResponseModel), err
+	}
+	if active {
+		if !model.PublishDraft {
+			return *new(models.WorkflowUpdateResponseModel), errors.New("workflow " + model.Name + " is active and cannot be modified, set publish_draft = true to load the new definition into a draft and publish it")
+		}
+
+		err = w.publishDraft(ctx, model)
+		if err != nil {
+			return *new(models.WorkflowUpdateResponseModel), err
+		}
+
+		tflog.Info(ctx, "success update workflow")
+		return models.WorkflowUpdateResponseModel{
+			Name: model.Name,
+		}, nil
+	}
+
+	// inactive schemes keep pointing at the live workflow through the rename below and would end up on the backup
+	if schemeLinkPattern.MatchString(row) {
+		return *new(models.WorkflowUpdateResponseModel), errors.New("workflow " + model.Name + " is used by inactive workflow schemes, remove it from them before changing its definition")
+	}
+
+	// import next to the live workflow first, it is only swapped in once jira accepted the definition
+	importName := model.Name + importSuffix
+	backupName := model.Name + backupSuffix
+	_, _ = w.Delete(ctx, models.WorkflowDeleteRequestModel{
+		Name: importName,
+	})
+	_, err = w.Create(ctx, models.WorkflowCreateRequestModel{
+		Name:        importName,
+		Description: model.Description,
+		Definition:  model.Definition,
+	})
+	if err != nil {
+		log.Println("failed to import new workflow definition")
+		return *new(models.WorkflowUpdateResponseModel), err
+	}
+
+	err = w.rename(ctx, model.Name, backupName, model.Description)
+	if err != nil {
+		log.Println("failed to move live workflow aside")
+		_, _ = w.Delete(ctx, models.WorkflowDeleteRequestModel{
+			Name: importName,
+		})
+		return *new(models.WorkflowUpdateResponseModel), err
+	}
+
+	err = w.rename(ctx, importName, model.Name, model.Description)
+	if err != nil {
+		log.Println("failed to swap in imported workflow, restoring the previous one")
+		if restoreErr := w.rename(ctx, backupName, model.Name, model.Description); restoreErr != nil {
+			return *new(models.WorkflowUpdateResponseModel), errors.New("failed to swap in workflow " + model.Name + " and to restore it, the previous definition is kept as " + backupName)
+		}
+		_, _ = w.Delete(ctx, models.WorkflowDeleteRequestModel{
+			Name: importName,
+		})
+		return *new(models.WorkflowUpdateResponseModel), err
+	}
+
+	_, err = w.Delete(ctx, models.WorkflowDeleteRequestModel{
+		Name: backupName,
+	})
+	if err != nil {
+		log.Println("failed to delete previous workflow definition")
+		return *new(models.WorkflowUpdateResponseModel), errors.New("workflow " + model.Name + " was updated but its previous definition is still kept as " + backupName)
+	}
+
+	tflog.Info(ctx, "success update workflow")
+	return models.WorkflowUpdateResponseModel{
+		Name: model.Name,
+	}, nil
+}
+
+func (w WorkflowService) Delete(ctx context.Context, model models.WorkflowDeleteRequestModel) (models.WorkflowDeleteResponseModel, error) {
+	tflog.Info(ctx, fmt.Sprintf("start delete workflow w. data: %v", model))
+
+	err := w.deleteMode(ctx, model.Name, "live")
+	if err != nil {
+		log.Println("failed to delete workflow")
+		return *new(models.WorkflowDeleteResponseModel), err
+	}
+
+	log.Println("delete workflow success")
+	return models.WorkflowDeleteResponseModel{}, nil
+}
+
+// publishDraft loads the definition into a draft of the active workflow and publishes it without keeping a backup.
+func (w WorkflowService) publishDraft(ctx context.Context, model models.WorkflowUpdateRequestModel) error {
+	tflog.Info(ctx, "start publish draft of workflow "+model.Name)
+
+	hash, err := DefinitionHash(model.Definition)
+	if err != nil {
+		return err
+	}
+
+	form := url2.Values{}
+	form.Set("workflowName", model.Name)
+	form.Set("workflowMode", "live")
+	_, err = baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/CreateDraftWorkflow.jspa", form)
+	if err != nil {
+		log.Println("failed to create draft workflow")
+		return err
+	}
+
+	form = url2.Values{}
+	form.Set("name", model.Name)
+	form.Set("workflowMode", "draft")
+	form.Set("description", model.Description)
+	form.Set("workflowXML", model.Definition)
+	_, err = baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/ImportWorkflowFromXml.jspa", form)
+	if err != nil {
+		log.Println("failed to import into draft workflow")
+		_ = w.deleteMode(ctx, model.Name, "draft")
+		return err
+	}
+
+	// the admin actions answer with an html page either way, only publish a draft that holds the new definition
+	draft, err := w.export(ctx, model.Name, "draft")
+	if err == nil {
+		var draftHash string
+		draftHash, err = DefinitionHash(draft)
+		if err == nil && draftHash != hash {
+			err = errors.New("jira did not load the new definition into the draft of workflow " + model.Name + ", check the xml definition")
+		}
+	}
+	if err != nil {
+		_ = w.deleteMode(ctx, model.Name, "draft")
+		return err
+	}
+
+	form = url2.Values{}
+	form.Set("workflowName", model.Name)
+	form.Set("workflowMode", "draft")
+	form.Set("enableBackup", "false")
+	form.Set("Publish", "Publish")
+	_, err = baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/PublishDraftWorkflow.jspa", form)
+	if err != nil {
+		log.Println("failed to publish draft workflow")
+		return err
+	}
+
+	live, err := w.export(ctx, model.Name, "live")
+	if err != nil {
+		return err
+	}
+	liveHash, err := DefinitionHash(live)
+	if err != nil {
+		return err
+	}
+	if liveHash != hash {
+		return errors.New("jira did not publish the draft of workflow " + model.Name + ", it is still pending on the workflow admin page")
+	}
+
+	tflog.Info(ctx, "success publish draft of workflow "+model.Name)
+	return nil
+}
+
+// export downloads the xml of the live workflow or of its draft.
+func (w WorkflowService) export(ctx context.Context, name string, mode string) (string, error) {
+	form := url2.Values{}
+	form.Set("workflowMode", mode)
+	form.Set("workflowName", name)
+
+	body, err := baseservice.SendForm(ctx, w.JiraServerBase, http.MethodGet, "/secure/admin/workflows/ViewWorkflowXml.jspa", form)
+	if err != nil {
+		log.Println("failed to export workflow")
+		return "", err
+	}
+	if !strings.Contains(string(body), "<workflow") {
+		return "", errors.New("failed to export " + mode + " workflow " + name)
+	}
+	return string(body), nil
+}
+
+// deleteMode deletes the live workflow or discards its draft, a workflow that is already gone is not an error.
+func (w WorkflowService) deleteMode(ctx context.Context, name string, mode string) error {
+	form := url2.Values{}
+	form.Set("workflowName", name)
+	form.Set("workflowMode", mode)
+	form.Set("confirmedDelete", "true")
+
+	_, err := baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/DeleteWorkflow.jspa", form)
+	if errors.Is(err, baseservice.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// findRow returns the row of the workflow admin page listing the workflow and whether it is in the active section,
+// jira server has no rest api for either.
+func (w WorkflowService) findRow(ctx context.Context, name string) (string, bool, error) {
+	body, err := baseservice.SendForm(ctx, w.JiraServerBase, http.MethodGet, "/secure/admin/workflows/ListWorkflows.jspa", nil)
+	if err != nil {
+		log.Println("failed to read workflow admin page")
+		return "", false, err
+	}
+
+	page := string(body)
+	start := strings.Index(page, activeWorkflowsMarker)
+	if start < 0 {
+		return "", false, errors.New("failed to check whether workflow " + name + " is active, the workflow admin page has no active workflows table")
+	}
+	activeSection := page[start:]
+	inactiveSection := ""
+	if end := strings.Index(activeSection, inactiveWorkflowsMarker); end >= 0 {
+		inactiveSection = activeSection[end:]
+		activeSection = activeSection[:end]
+	}
+
+	namePattern := regexp.MustCompile(`>\s*` + regexp.QuoteMeta(html.EscapeString(name)) + `\s*<`)
+	for _, row := range strings.Split(activeSection, "<tr") {
+		if namePattern.MatchString(row) {
+			return row, true, nil
+		}
+	}
+	for _, row := range strings.Split(inactiveSection, "<tr") {
+		if namePattern.MatchString(row) {
+			return row, false, nil
+		}
+	}
+	return "", false, errors.New("failed to find workflow " + name + " on the workflow admin page")
+}
+
+// rename changes the name of an inactive workflow through the workflow edit form.
+func (w WorkflowService) rename(ctx context.Context, name string, newName string, description string) error {
+	tflog.Info(ctx, "start rename workflow "+name+" to "+newName)
+
+	form := url2.Values{}
+	form.Set("workflowName", name)
+	form.Set("workflowMode", "live")
+	form.Set("newWorkflowName", newName)
+	form.Set("description", description)
+
+	_, err := baseservice.SendForm(ctx, w.JiraServerBase, http.MethodPost, "/secure/admin/workflows/EditWorkflow.jspa", form)
+	if err != nil {
+		log.Println("failed to rename workflow")
+		return err
+	}
+
+	// the admin action answers with an html page either way, check the new name exists
+	_, err = w.Get(ctx, models.WorkflowGetRequestModel{
+		Name: newName,
+	})
+	if err != nil {
+		return errors.New("jira did not rename workflow " + name + " to " + newName)
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+package models
+
+type WorkflowCreateRequestModel struct {
+	Name        string
+	Description string
+	Definition  string
+}
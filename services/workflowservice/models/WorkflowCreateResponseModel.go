@@ -0,0 +1,5 @@
+package models
+
+type WorkflowCreateResponseModel struct {
+	Name string
+}
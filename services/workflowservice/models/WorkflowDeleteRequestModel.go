@@ -0,0 +1,5 @@
+package models
+
+type WorkflowDeleteRequestModel struct {
+	Name string
+}
@@ -0,0 +1,5 @@
+package models
+
+type WorkflowGetRequestModel struct {
+	Name string
+}
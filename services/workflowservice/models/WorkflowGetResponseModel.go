@@ -0,0 +1,9 @@
+package models
+
+type WorkflowGetResponseModel struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Steps       int64  `json:"steps"`
+	Default     bool   `json:"default"`
+	Definition  string `json:"-"`
+}
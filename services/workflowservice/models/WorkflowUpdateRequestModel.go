@@ -0,0 +1,8 @@
+package models
+
+type WorkflowUpdateRequestModel struct {
+	Name         string
+	Description  string
+	Definition   string
+	PublishDraft bool
+}
@@ -0,0 +1,5 @@
+package models
+
+type WorkflowUpdateResponseModel struct {
+	Name string
+}